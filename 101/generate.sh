@@ -0,0 +1,6 @@
+#!/bin/bash
+
+protoc countries.proto -I . -I third_party \
+  --go_out=plugins=grpc:. \
+  --grpc-gateway_out=. \
+  --openapiv2_out=server/openapi
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// provenanceKey is the response metadata key Search reports the provenance of fields in
+const provenanceKey = "x-country-provenance"
+
+// Provider is a named CountrySource taking part in an aggregation
+type Provider struct {
+	Name   string
+	Source CountrySource
+}
+
+// AggregateSource asks several providers at once and merges their answers
+// field by field: the first provider, the primary, is preferred and the
+// next ones fill the fields it lacks, or everything when it fails.
+// Every merged country records which provider supplied each field.
+type AggregateSource struct {
+	providers []Provider
+	timeout   time.Duration
+}
+
+// NewAggregateSource aggregates providers, in order of preference. Each
+// provider has timeout to answer, 0 meaning no limit, and at most three
+// quarters of the time the caller has left, so that a hung provider
+// leaves time to merge the answers of the others.
+func NewAggregateSource(timeout time.Duration, providers ...Provider) *AggregateSource {
+	return &AggregateSource{providers: providers, timeout: timeout}
+}
+
+// Search asks every provider for countries matching name
+func (a *AggregateSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	return a.merge(ctx, func(ctx context.Context, source CountrySource) ([]*countries.CountryResponse, error) {
+		return source.Search(ctx, name)
+	})
+}
+
+// Lookup asks every provider for countries whose field by matches value
+func (a *AggregateSource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	return a.merge(ctx, func(ctx context.Context, source CountrySource) ([]*countries.CountryResponse, error) {
+		return source.Lookup(ctx, by, value)
+	})
+}
+
+// Ping reports the upstream as reachable while any provider is, providers
+// without an upstream, like files, always are
+func (a *AggregateSource) Ping(ctx context.Context) error {
+	var errs []string
+	for _, provider := range a.providers {
+		pinger, ok := provider.Source.(Pinger)
+		if !ok {
+			return nil
+		}
+		err := pinger.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, provider.Name+": "+err.Error())
+	}
+	return fmt.Errorf("no provider is reachable: %s", strings.Join(errs, ", "))
+}
+
+// Suggest asks the providers able to suggest names, in order, until one does
+func (a *AggregateSource) Suggest(ctx context.Context, name string, max int) []string {
+	for _, provider := range a.providers {
+		if suggester, ok := provider.Source.(Suggester); ok {
+			if names := suggester.Suggest(ctx, name, max); len(names) > 0 {
+				return names
+			}
+		}
+	}
+	return nil
+}
+
+// Stats returns the retry and circuit breaker counters of the providers having them
+func (a *AggregateSource) Stats() map[string]ResilienceStats {
+	stats := make(map[string]ResilienceStats)
+	for _, provider := range a.providers {
+		if resilient, ok := provider.Source.(*ResilientSource); ok {
+			stats[provider.Name] = resilient.Stats()
+		}
+	}
+	return stats
+}
+
+type providerAnswer struct {
+	data []*countries.CountryResponse
+	err  error
+}
+
+// providerContext bounds the time a provider has to answer
+func (a *AggregateSource) providerContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := a.timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline) * 3 / 4; timeout <= 0 || left < timeout {
+			timeout = left
+		}
+	} else if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (a *AggregateSource) merge(ctx context.Context, fetch func(ctx context.Context, source CountrySource) ([]*countries.CountryResponse, error)) ([]*countries.CountryResponse, error) {
+	providerCtx, cancel := a.providerContext(ctx)
+	defer cancel()
+	answers := make([]providerAnswer, len(a.providers))
+	var wg sync.WaitGroup
+	for i, provider := range a.providers {
+		wg.Add(1)
+		go func(i int, source CountrySource) {
+			defer wg.Done()
+			data, err := fetch(providerCtx, source)
+			answers[i] = providerAnswer{data: data, err: err}
+		}(i, provider.Source)
+	}
+	wg.Wait()
+
+	var merged []*countries.CountryResponse
+	index := make(map[string]*countries.CountryResponse)
+	notFound := false
+	for i, answer := range answers {
+		if answer.err != nil {
+			notFound = notFound || errors.Is(answer.err, errCountryNotFound)
+			continue
+		}
+		name := a.providers[i].Name
+		for _, country := range answer.data {
+			key := countryKey(country)
+			if into, ok := index[key]; ok {
+				fillFields(into, country, name)
+				continue
+			}
+			country.Provenance = nil
+			fillFields(country, country, name)
+			index[key] = country
+			merged = append(merged, country)
+		}
+	}
+	switch {
+	case len(merged) > 0:
+		return merged, nil
+	case notFound:
+		// a provider that answered knows better than the ones that failed
+		return nil, errCountryNotFound
+	default:
+		return nil, answers[0].err
+	}
+}
+
+// countryKey tells which answers of different providers are the same country
+func countryKey(country *countries.CountryResponse) string {
+	switch {
+	case country.Alpha3Code != "":
+		return strings.ToUpper(country.Alpha3Code)
+	case country.Alpha2Code != "":
+		return strings.ToUpper(country.Alpha2Code)
+	default:
+		return foldName(country.Name)
+	}
+}
+
+// fillFields copies the fields set in from but not in into, and records
+// provider as their provenance. Passing the same message twice records the
+// provenance of the fields it already has.
+func fillFields(into, from *countries.CountryResponse, provider string) {
+	dst, src := into.ProtoReflect(), from.ProtoReflect()
+	provenance := dst.Descriptor().Fields().ByName("provenance")
+	if into.Provenance == nil {
+		into.Provenance = make(map[string]string)
+	}
+	src.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field == provenance {
+			return true
+		}
+		if _, ok := into.Provenance[string(field.Name())]; ok {
+			return true
+		}
+		if into != from {
+			dst.Set(field, value)
+		}
+		into.Provenance[string(field.Name())] = provider
+		return true
+	})
+}
+
+// provenanceHeader renders the provenance of a country as a metadata value
+// like "area=file,name=restcountries"
+func provenanceHeader(country *countries.CountryResponse) string {
+	fields := make([]string, 0, len(country.Provenance))
+	for field, provider := range country.Provenance {
+		fields = append(fields, field+"="+provider)
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// brazilV3 is how the restcountries v3 API describes Brazil, trimmed down
+const brazilV3 = `[{
+	"name": {"common": "Brazil", "official": "Federative Republic of Brazil",
+		"nativeName": {"por": {"official": "República Federativa do Brasil", "common": "Brasil"}}},
+	"cca2": "BR", "cca3": "BRA", "capital": ["Brasília"],
+	"region": "Americas", "subregion": "South America", "population": 212559409,
+	"currencies": {"BRL": {"name": "Brazilian real", "symbol": "R$"}},
+	"languages": {"por": "Portuguese"},
+	"idd": {"root": "+5", "suffixes": ["5"]},
+	"flags": {"png": "https://flagcdn.com/w320/br.png", "svg": "https://flagcdn.com/br.svg"}
+}]`
+
+func TestAggregateSource(t *testing.T) {
+	down := false
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case down:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.URL.Path == "/name/Brazil":
+			fmt.Fprint(w, brazilV3)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer primary.Close()
+	options := DefaultHTTPOptions
+	options.API = "v3"
+	fallback, err := LoadFileSource("testdata/countries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := NewAggregateSource(0,
+		Provider{Name: "restcountries", Source: NewHTTPSource(primary.URL, options)},
+		Provider{Name: "file", Source: fallback},
+	)
+	ctx := context.Background()
+
+	data, err := source.Search(ctx, "Brazil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	brazil := data[0]
+	if brazil.NativeName != "Brasil" || brazil.Area != 8515767 || len(brazil.CallingCodes) != 1 || brazil.CallingCodes[0] != "55" {
+		t.Errorf("fields were not merged: %v", brazil)
+	}
+	// v3 codes languages in ISO 639-3, not in the ISO 639-2 of v2
+	if len(brazil.Languages) != 1 || brazil.Languages[0].Iso639_3 != "por" || brazil.Languages[0].Iso639_2 != "" {
+		t.Errorf("unexpected languages %v", brazil.Languages)
+	}
+	for field, want := range map[string]string{"name": "restcountries", "population": "restcountries", "area": "file", "borders": "file"} {
+		if got := brazil.Provenance[field]; got != want {
+			t.Errorf("%s came from %q, want %q", field, got, want)
+		}
+	}
+
+	// the fallback answers alone while the primary is down
+	down = true
+	data, err = source.Search(ctx, "Brazil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data[0].Provenance["name"] != "file" || data[0].Provenance["population"] != "file" {
+		t.Errorf("expected every field from the file, got %v", data[0].Provenance)
+	}
+	if _, err := source.Search(ctx, "Atlantis"); err != errCountryNotFound {
+		t.Errorf("expected errCountryNotFound, got %v", err)
+	}
+
+	// the error of the primary is reported when every provider fails
+	failing := NewAggregateSource(0, Provider{Name: "restcountries", Source: NewHTTPSource(primary.URL, options)})
+	if _, err := failing.Search(ctx, "Brazil"); status.Code(toStatus(err, "Brazil")) != codes.Unavailable {
+		t.Errorf("expected the primary to be unavailable, got %v", err)
+	}
+}
+
+func TestAggregateSourceHungPrimary(t *testing.T) {
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hung.Close()
+	fallback, err := LoadFileSource("testdata/countries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary := NewResilientSource(NewHTTPSource(hung.URL, DefaultHTTPOptions),
+		RetryOptions{MaxAttempts: 1},
+		BreakerOptions{FailureThreshold: 2, OpenTimeout: time.Minute, HalfOpenProbes: 1},
+	)
+	source := NewAggregateSource(0,
+		Provider{Name: "restcountries", Source: primary},
+		Provider{Name: "file", Source: fallback},
+	)
+
+	// the fallback answers before the caller gives up
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		data, err := source.Search(ctx, "Brazil")
+		cancel()
+		if err != nil || data[0].Provenance["name"] != "file" {
+			t.Fatalf("expected the file to answer, got %v %v", data, err)
+		}
+	}
+	if stats := primary.Stats(); stats.State != circuitOpen {
+		t.Errorf("expected the hung primary to open its circuit, got %+v", stats)
+	}
+
+	// callers without deadline wait for the provider timeout at most
+	bounded := NewAggregateSource(50*time.Millisecond,
+		Provider{Name: "restcountries", Source: NewHTTPSource(hung.URL, DefaultHTTPOptions)},
+		Provider{Name: "file", Source: fallback},
+	)
+	start := time.Now()
+	if _, err := bounded.Search(context.Background(), "Brazil"); err != nil || time.Since(start) > time.Second {
+		t.Errorf("expected the file to answer after the provider timeout, got %v after %v", err, time.Since(start))
+	}
+}
+
+func TestSearchProvenanceHeader(t *testing.T) {
+	fallback, err := LoadFileSource("testdata/countries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := NewAggregateSource(0,
+		Provider{Name: "partial", Source: NewMemorySource(&countries.CountryResponse{Name: "Japan", Alpha3Code: "JPN"})},
+		Provider{Name: "file", Source: fallback},
+	)
+	client := dialTestServer(t, &Server{source: source})
+
+	var header metadata.MD
+	response, err := client.Search(context.Background(), &countries.CountryRequest{Name: "Japan"}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Capital != "Tokyo" || response.Provenance["capital"] != "file" {
+		t.Errorf("unexpected response %v", response)
+	}
+	values := header.Get(provenanceKey)
+	if len(values) != 1 || values[0] != provenanceHeader(response) {
+		t.Errorf("expected provenance in the header, got %v", values)
+	}
+
+	// batches carry the provenance of each country in the country itself
+	header = nil
+	batch, err := client.BatchSearch(context.Background(), &countries.BatchSearchRequest{Names: []string{"Japan", "Brazil"}}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values := header.Get(provenanceKey); len(values) != 0 {
+		t.Errorf("expected no provenance header on a batch, got %v", values)
+	}
+	if country := batch.Results[0].GetCountry(); country == nil || country.Provenance["capital"] != "file" {
+		t.Errorf("unexpected batch result %v", batch.Results[0])
+	}
+}
+
+func TestProviderListFlag(t *testing.T) {
+	var providers ProviderList
+	value := "restcountries=v3:https://restcountries.com/v3.1,local=file:countries.json"
+	if err := providers.Set(value); err != nil {
+		t.Fatal(err)
+	}
+	if len(providers) != 2 || providers[0].URL != "https://restcountries.com/v3.1" || providers[1].Data != "countries.json" {
+		t.Errorf("unexpected providers %+v", providers)
+	}
+	if providers.String() != value {
+		t.Errorf("String() = %q", providers.String())
+	}
+	if err := providers.Set("restcountries"); err == nil {
+		t.Error("expected an error for a provider without kind")
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultBatchConcurrency is used when the server has no limit configured
+	defaultBatchConcurrency = 8
+	// maxBatchSize bounds the names of a single BatchSearch request
+	maxBatchSize = 1000
+)
+
+// BatchSearch searches every distinct name of the request, at most
+// batchConcurrency at a time, and reports a result for each of them
+func (s *Server) BatchSearch(ctx context.Context, request *countries.BatchSearchRequest) (*countries.BatchSearchResponse, error) {
+	if len(request.Names) > maxBatchSize {
+		return nil, invalidArgument("names", fmt.Sprintf("at most %d names can be searched at once", maxBatchSize))
+	}
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range request.Names {
+		if key := cacheKey(name); !seen[key] {
+			seen[key] = true
+			names = append(names, name)
+		}
+	}
+
+	results := make([]*countries.BatchSearchResult, len(names))
+	sem := make(chan struct{}, s.concurrency())
+	var wg sync.WaitGroup
+	for i, name := range names {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = s.batchResult(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+	return &countries.BatchSearchResponse{Results: results}, nil
+}
+
+// BatchSearchStream searches names as they arrive, at most batchConcurrency
+// at a time, and streams each result back as soon as it is known
+func (s *Server) BatchSearchStream(stream countries.Country_BatchSearchStreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	results := make(chan *countries.BatchSearchResult)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		var wg sync.WaitGroup
+		defer close(results)
+		defer wg.Wait()
+		sem := make(chan struct{}, s.concurrency())
+		seen := make(map[string]bool)
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+			key := cacheKey(request.Name)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				result := s.batchResult(ctx, name)
+				select {
+				case results <- result:
+				case <-ctx.Done():
+				}
+			}(request.Name)
+		}
+	}()
+
+	for result := range results {
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// batchResult searches a single name of a batch
+func (s *Server) batchResult(ctx context.Context, name string) *countries.BatchSearchResult {
+	result := &countries.BatchSearchResult{Name: name}
+	country, err := s.search(ctx, name)
+	if err != nil {
+		result.Result = &countries.BatchSearchResult_Error{Error: status.Convert(err).Proto()}
+	} else {
+		result.Result = &countries.BatchSearchResult_Country{Country: country}
+	}
+	return result
+}
+
+func (s *Server) concurrency() int {
+	if s.batchConcurrency < 1 {
+		return defaultBatchConcurrency
+	}
+	return s.batchConcurrency
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// slowSource records how many searches run at the same time
+type slowSource struct {
+	CountrySource
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (s *slowSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > s.peak {
+		s.peak = s.inFlight
+	}
+	s.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+	return s.CountrySource.Search(ctx, name)
+}
+
+func batchOutcome(result *countries.BatchSearchResult) string {
+	if country := result.GetCountry(); country != nil {
+		return country.Alpha2Code
+	}
+	return codes.Code(result.GetError().Code).String()
+}
+
+func TestBatchSearch(t *testing.T) {
+	source := &slowSource{CountrySource: newTestServer(t).source}
+	client := dialTestServer(t, &Server{source: source, batchConcurrency: 2})
+
+	names := []string{"Brazil", "Japan", "brazil", "Atlantis", " ", "India", "Germany"}
+	want := map[string]string{
+		"Brazil":   "BR",
+		"Japan":    "JP",
+		"Atlantis": "NotFound",
+		" ":        "InvalidArgument",
+		"India":    "IN",
+		"Germany":  "DE",
+	}
+
+	response, err := client.BatchSearch(context.Background(), &countries.BatchSearchRequest{Names: names})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Results) != len(want) {
+		t.Errorf("expected %d deduplicated results, got %d", len(want), len(response.Results))
+	}
+	for _, result := range response.Results {
+		if got := batchOutcome(result); got != want[result.Name] {
+			t.Errorf("BatchSearch result for %q = %v, want %v", result.Name, got, want[result.Name])
+		}
+	}
+	if source.peak > 2 {
+		t.Errorf("expected at most 2 concurrent searches, got %d", source.peak)
+	}
+
+	stream, err := client.BatchSearchStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		if err := stream.Send(&countries.CountryRequest{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+	got := 0
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got++
+		if outcome := batchOutcome(result); outcome != want[result.Name] {
+			t.Errorf("BatchSearchStream result for %q = %v, want %v", result.Name, outcome, want[result.Name])
+		}
+	}
+	if got != len(want) {
+		t.Errorf("expected %d streamed results, got %d", len(want), got)
+	}
+}
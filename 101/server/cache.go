@@ -0,0 +1,160 @@
+package main
+
+import (
+	"container/list"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheStats are the counters exposed by CachedSource
+type CacheStats struct {
+	Hits         uint64 `json:"hits"`
+	NegativeHits uint64 `json:"negative_hits"`
+	Misses       uint64 `json:"misses"`
+	Evictions    uint64 `json:"evictions"`
+	Entries      int    `json:"entries"`
+}
+
+// CachedSource keeps recent answers of another CountrySource in memory.
+// Not-found answers are cached too, for negativeTTL, so repeated
+// lookups of unknown names don't reach the upstream either.
+type CachedSource struct {
+	source      CountrySource
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	stats   CacheStats
+}
+
+type cacheEntry struct {
+	key     string
+	data    []*countries.CountryResponse // nil when the name was not found
+	expires time.Time
+}
+
+// NewCachedSource wraps source with an LRU cache of at most maxEntries names
+func NewCachedSource(source CountrySource, ttl, negativeTTL time.Duration, maxEntries int) *CachedSource {
+	return &CachedSource{
+		source:      source,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		maxEntries:  maxEntries,
+		now:         time.Now,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+	}
+}
+
+// cacheKey normalizes a country name so "Brazil" and " brazil" share an entry
+func cacheKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Search answers from the cache when it can and asks the wrapped source otherwise
+func (c *CachedSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	return c.cached("name:"+cacheKey(name), func() ([]*countries.CountryResponse, error) {
+		return c.source.Search(ctx, name)
+	})
+}
+
+// Lookup is cached like Search, in a key space of its own per field
+func (c *CachedSource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	return c.cached(by+":"+cacheKey(value), func() ([]*countries.CountryResponse, error) {
+		return c.source.Lookup(ctx, by, value)
+	})
+}
+
+func (c *CachedSource) cached(key string, fetch func() ([]*countries.CountryResponse, error)) ([]*countries.CountryResponse, error) {
+	if data, found, ok := c.get(key); ok {
+		if !found {
+			return nil, errCountryNotFound
+		}
+		return cloneCountries(data), nil
+	}
+
+	data, err := fetch()
+	switch {
+	case errors.Is(err, errCountryNotFound):
+		if c.negativeTTL > 0 {
+			c.put(key, nil, c.negativeTTL)
+		}
+		return nil, err
+	case err != nil:
+		return nil, err
+	}
+	c.put(key, data, c.ttl)
+	return cloneCountries(data), nil
+}
+
+// get looks key up; ok reports a live entry and found whether it was a positive one
+func (c *CachedSource) get(key string) (data []*countries.CountryResponse, found, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		c.stats.Misses++
+		return nil, false, false
+	}
+	c.lru.MoveToFront(elem)
+	if entry.data == nil {
+		c.stats.NegativeHits++
+		return nil, false, true
+	}
+	c.stats.Hits++
+	return entry.data, true, true
+}
+
+func (c *CachedSource) put(key string, data []*countries.CountryResponse, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &cacheEntry{key: key, data: data, expires: c.now().Add(ttl)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+// Stats returns a snapshot of the cache counters
+func (c *CachedSource) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.lru.Len()
+	return stats
+}
+
+// cloneCountries copies cached messages so callers can't modify the cache
+func cloneCountries(data []*countries.CountryResponse) []*countries.CountryResponse {
+	out := make([]*countries.CountryResponse, len(data))
+	for i, c := range data {
+		out[i] = proto.Clone(c).(*countries.CountryResponse)
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+)
+
+// countingSource counts how often the cache falls through to it
+type countingSource struct {
+	CountrySource
+	calls int
+}
+
+func (s *countingSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	s.calls++
+	return s.CountrySource.Search(ctx, name)
+}
+
+func TestCachedSource(t *testing.T) {
+	ctx := context.Background()
+	upstream := &countingSource{CountrySource: NewMemorySource(
+		&countries.CountryResponse{Name: "Brazil", Alpha2Code: "BR"},
+		&countries.CountryResponse{Name: "Germany", Alpha2Code: "DE"},
+	)}
+	now := time.Unix(0, 0)
+	cache := NewCachedSource(upstream, time.Minute, 10*time.Second, 2)
+	cache.now = func() time.Time { return now }
+
+	for _, name := range []string{"Brazil", " brazil", "BRAZIL"} {
+		data, err := cache.Search(ctx, name)
+		if err != nil || data[0].Alpha2Code != "BR" {
+			t.Fatalf("Search(%q) = %v, %v", name, data, err)
+		}
+	}
+	if upstream.calls != 1 {
+		t.Errorf("expected 1 upstream call, got %d", upstream.calls)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Search(ctx, "Atlantis"); err != errCountryNotFound {
+			t.Fatalf("expected errCountryNotFound, got %v", err)
+		}
+	}
+	if upstream.calls != 2 {
+		t.Errorf("not found answer should be cached, got %d upstream calls", upstream.calls)
+	}
+
+	// Germany evicts Brazil, the least recently used entry
+	cache.Search(ctx, "Germany")
+	cache.Search(ctx, "Brazil")
+	if upstream.calls != 4 {
+		t.Errorf("expected Brazil to be evicted, got %d upstream calls", upstream.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	cache.Search(ctx, "Brazil")
+	if upstream.calls != 5 {
+		t.Errorf("expected expired entry to be refreshed, got %d upstream calls", upstream.calls)
+	}
+
+	stats := cache.Stats()
+	want := CacheStats{Hits: 2, NegativeHits: 1, Misses: 5, Evictions: 2, Entries: 2}
+	if stats != want {
+		t.Errorf("Stats() = %+v, want %+v", stats, want)
+	}
+}
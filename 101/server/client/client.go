@@ -0,0 +1,222 @@
+// Package client is a Go client for the Country gRPC service, adding
+// per-call timeouts and retries of transient failures to the generated stub.
+package client
+
+import (
+	"crypto/tls"
+	"io"
+	"math/rand"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Options tunes how the client calls the server
+type Options struct {
+	// Timeout bounds every attempt of a call, 0 means no limit
+	Timeout time.Duration
+	// MaxAttempts is the total number of tries of a call, 1 disables retries
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled on each next one
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, a server asking to wait longer fails the call
+	MaxDelay time.Duration
+	// TLS enables TLS with the given configuration, nil dials in plaintext
+	TLS *tls.Config
+	// ClientID identifies the caller to the server rate limits, which
+	// tell callers without one apart by their address
+	ClientID string
+}
+
+// DefaultOptions are used by Dial unless told otherwise
+var DefaultOptions = Options{
+	Timeout:     10 * time.Second,
+	MaxAttempts: 3,
+	BaseDelay:   100 * time.Millisecond,
+	MaxDelay:    2 * time.Second,
+}
+
+// Client calls the Country service
+type Client struct {
+	rpc     countries.CountryClient
+	conn    *grpc.ClientConn
+	options Options
+}
+
+// Dial connects to the Country server at target, dialOpts are appended
+// to the ones derived from options
+func Dial(ctx context.Context, target string, options Options, dialOpts ...grpc.DialOption) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if options.TLS != nil {
+		creds = credentials.NewTLS(options.TLS)
+	}
+	dialOpts = append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, dialOpts...)
+	conn, err := grpc.DialContext(ctx, target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	client := New(conn, options)
+	client.conn = conn
+	return client, nil
+}
+
+// New creates a client over an existing connection, which Close leaves open
+func New(conn grpc.ClientConnInterface, options Options) *Client {
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+	return &Client{rpc: countries.NewCountryClient(conn), options: options}
+}
+
+// Close closes the connection opened by Dial
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// Search returns the country best matching name
+func (c *Client) Search(ctx context.Context, name string) (*countries.CountryResponse, error) {
+	var response *countries.CountryResponse
+	err := c.call(ctx, func(ctx context.Context) (err error) {
+		response, err = c.rpc.Search(ctx, &countries.CountryRequest{Name: name})
+		return err
+	})
+	return response, err
+}
+
+// SearchAll returns every country matching the request
+func (c *Client) SearchAll(ctx context.Context, request *countries.SearchAllRequest) ([]*countries.CountryResponse, error) {
+	var data []*countries.CountryResponse
+	err := c.call(ctx, func(ctx context.Context) error {
+		stream, err := c.rpc.SearchAll(ctx, request)
+		if err != nil {
+			return err
+		}
+		data, err = receiveAll(stream)
+		return err
+	})
+	return data, err
+}
+
+// Lookup returns every country matching the field selected in the request
+func (c *Client) Lookup(ctx context.Context, request *countries.LookupRequest) ([]*countries.CountryResponse, error) {
+	var data []*countries.CountryResponse
+	err := c.call(ctx, func(ctx context.Context) error {
+		stream, err := c.rpc.Lookup(ctx, request)
+		if err != nil {
+			return err
+		}
+		data, err = receiveAll(stream)
+		return err
+	})
+	return data, err
+}
+
+// BatchSearch searches many names at once, failed names are reported
+// in their result rather than failing the call
+func (c *Client) BatchSearch(ctx context.Context, names ...string) ([]*countries.BatchSearchResult, error) {
+	var response *countries.BatchSearchResponse
+	err := c.call(ctx, func(ctx context.Context) (err error) {
+		response, err = c.rpc.BatchSearch(ctx, &countries.BatchSearchRequest{Names: names})
+		return err
+	})
+	return response.GetResults(), err
+}
+
+// receiveAll reads a country stream to its end
+func receiveAll(stream interface {
+	Recv() (*countries.CountryResponse, error)
+}) ([]*countries.CountryResponse, error) {
+	var data []*countries.CountryResponse
+	for {
+		country, err := stream.Recv()
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, country)
+	}
+}
+
+// call runs attempt until it succeeds, fails for good or runs out of tries
+func (c *Client) call(ctx context.Context, attempt func(ctx context.Context) error) error {
+	var err error
+	for i := 0; i < c.options.MaxAttempts; i++ {
+		if i > 0 {
+			delay, ok := c.backoff(i, err)
+			if !ok {
+				return err
+			}
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return status.FromContextError(ctx.Err()).Err()
+			case <-timer.C:
+			}
+		}
+		err = c.attempt(ctx, attempt)
+		if !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (c *Client) attempt(ctx context.Context, attempt func(ctx context.Context) error) error {
+	if c.options.ClientID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-client-id", c.options.ClientID)
+	}
+	if c.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.options.Timeout)
+		defer cancel()
+	}
+	return attempt(ctx)
+}
+
+// backoff tells how long to wait before the given retry, using full jitter
+// unless the server said how long, and whether retrying is worth it at all
+func (c *Client) backoff(retry int, err error) (time.Duration, bool) {
+	if delay, ok := retryDelay(err); ok {
+		return delay, delay <= c.options.MaxDelay
+	}
+	delay := c.options.BaseDelay << (retry - 1)
+	if delay > c.options.MaxDelay || delay <= 0 {
+		delay = c.options.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1)), true
+}
+
+// retryable reports whether a failed call may succeed when tried again
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay reads the delay the server asked to wait before retrying
+func retryDelay(err error) (time.Duration, bool) {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
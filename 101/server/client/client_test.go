@@ -0,0 +1,125 @@
+package client
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// flakyServer fails the first calls of every name with the error set for it
+type flakyServer struct {
+	countries.UnimplementedCountryServer
+	mu       sync.Mutex
+	failures map[string]int
+	errs     map[string]error
+	calls    map[string]int
+}
+
+func (s *flakyServer) Search(ctx context.Context, request *countries.CountryRequest) (*countries.CountryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[request.Name]++
+	if s.calls[request.Name] <= s.failures[request.Name] {
+		return nil, s.errs[request.Name]
+	}
+	return &countries.CountryResponse{Name: request.Name}, nil
+}
+
+func (s *flakyServer) SearchAll(request *countries.SearchAllRequest, stream countries.Country_SearchAllServer) error {
+	for _, name := range []string{"Guinea", "Guinea-Bissau"} {
+		if err := stream.Send(&countries.CountryResponse{Name: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func retryAfter(delay time.Duration) error {
+	st, _ := status.New(codes.Unavailable, "upstream is down").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	})
+	return st.Err()
+}
+
+func dialFlakyServer(t *testing.T, server *flakyServer, options Options) *Client {
+	listen := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	countries.RegisterCountryServer(grpcServer, server)
+	go grpcServer.Serve(listen)
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := Dial(context.Background(), "bufnet", options,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listen.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestClientRetries(t *testing.T) {
+	server := &flakyServer{
+		failures: map[string]int{"Brazil": 2, "Busyland": 5, "Atlantis": 5, "Slowvakia": 1},
+		errs: map[string]error{
+			"Brazil":    status.Error(codes.Unavailable, "upstream is down"),
+			"Busyland":  status.Error(codes.Unavailable, "upstream is down"),
+			"Atlantis":  status.Error(codes.NotFound, "country Atlantis not found"),
+			"Slowvakia": retryAfter(time.Minute),
+		},
+		calls: make(map[string]int),
+	}
+	options := DefaultOptions
+	options.BaseDelay = time.Millisecond
+	options.MaxDelay = 10 * time.Millisecond
+	client := dialFlakyServer(t, server, options)
+
+	tests := []struct {
+		name  string
+		code  codes.Code
+		calls int
+	}{
+		{name: "Brazil", code: codes.OK, calls: 3},
+		{name: "Busyland", code: codes.Unavailable, calls: 3},
+		// not found is an answer, not a failure
+		{name: "Atlantis", code: codes.NotFound, calls: 1},
+		// the server asks to wait longer than MaxDelay
+		{name: "Slowvakia", code: codes.Unavailable, calls: 1},
+	}
+	for _, test := range tests {
+		response, err := client.Search(context.Background(), test.name)
+		if code := status.Code(err); code != test.code {
+			t.Errorf("Search(%q) code = %v, want %v", test.name, code, test.code)
+		}
+		if err == nil && response.Name != test.name {
+			t.Errorf("Search(%q) = %v", test.name, response)
+		}
+		if calls := server.calls[test.name]; calls != test.calls {
+			t.Errorf("Search(%q) made %d calls, want %d", test.name, calls, test.calls)
+		}
+	}
+}
+
+func TestClientSearchAll(t *testing.T) {
+	client := dialFlakyServer(t, &flakyServer{}, DefaultOptions)
+	data, err := client.SearchAll(context.Background(), &countries.SearchAllRequest{Name: "guinea"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2 || data[1].Name != "Guinea-Bissau" {
+		t.Errorf("unexpected countries %v", data)
+	}
+}
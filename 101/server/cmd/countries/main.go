@@ -0,0 +1,224 @@
+// Command countries queries the Country service from the shell, e.g.
+//
+//	countries search brazil --output json
+//	countries search-all guinea --max 2 --output table
+//	countries lookup --currency EUR --output yaml
+//	countries batch brazil japan atlantis
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"server/client"
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+const usage = `usage: countries <command> [flags] [arguments]
+
+commands:
+  search NAME        the country best matching NAME
+  search-all NAME    every country matching NAME
+  lookup             countries matching --code, --currency, --capital, --region or --subregion
+  batch NAME...      search many names at once
+
+flags:
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("countries", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	addr := flags.String("addr", envOr("COUNTRY_ADDR", "localhost:3000"), "Country server address")
+	output := flags.String("output", "table", "output format: json, table or yaml")
+	timeout := flags.Duration("timeout", client.DefaultOptions.Timeout, "max duration of each attempt")
+	attempts := flags.Int("attempts", client.DefaultOptions.MaxAttempts, "tries per call, 1 disables retries")
+	useTLS := flags.Bool("tls", false, "connect with TLS")
+	caFile := flags.String("ca", "", "CA certificate verifying the server, implies --tls")
+	clientID := flags.String("client-id", os.Getenv("COUNTRY_CLIENT_ID"), "identity the server rate limits calls by")
+	exact := flags.Bool("exact", false, "search-all: only countries named exactly NAME")
+	max := flags.Int("max", 0, "search-all: stop after this many countries, 0 means no limit")
+	lookup := make(map[string]*string)
+	for _, field := range []string{"code", "currency", "capital", "region", "subregion"} {
+		lookup[field] = flags.String(field, "", "lookup: "+field+" to look countries up by")
+	}
+
+	if len(args) == 0 {
+		flags.Usage()
+		return 2
+	}
+	command := args[0]
+	positional, err := parseInterspersed(flags, args[1:])
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err != nil {
+		return 2
+	}
+	format, ok := formats[*output]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown output format %q\n", *output)
+		return 2
+	}
+
+	options := client.DefaultOptions
+	options.Timeout = *timeout
+	options.MaxAttempts = *attempts
+	options.ClientID = *clientID
+	if *useTLS || *caFile != "" {
+		options.TLS = &tls.Config{}
+		if *caFile != "" {
+			pool, err := loadCA(*caFile)
+			if err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+			options.TLS.RootCAs = pool
+		}
+	}
+
+	ctx := context.Background()
+	c, err := client.Dial(ctx, *addr, options)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer c.Close()
+
+	switch command {
+	case "search":
+		if len(positional) != 1 {
+			fmt.Fprintln(stderr, "search takes exactly one NAME")
+			return 2
+		}
+		var country *countries.CountryResponse
+		country, err = c.Search(ctx, positional[0])
+		if err == nil {
+			err = format.country(stdout, country)
+		}
+	case "search-all":
+		if len(positional) != 1 {
+			fmt.Fprintln(stderr, "search-all takes exactly one NAME")
+			return 2
+		}
+		var data []*countries.CountryResponse
+		data, err = c.SearchAll(ctx, &countries.SearchAllRequest{
+			Name:       positional[0],
+			ExactMatch: *exact,
+			MaxResults: int32(*max),
+		})
+		if err == nil {
+			err = format.countries(stdout, data)
+		}
+	case "lookup":
+		request, lookupErr := lookupRequest(lookup)
+		if lookupErr != nil {
+			fmt.Fprintln(stderr, lookupErr)
+			return 2
+		}
+		var data []*countries.CountryResponse
+		data, err = c.Lookup(ctx, request)
+		if err == nil {
+			err = format.countries(stdout, data)
+		}
+	case "batch":
+		if len(positional) == 0 {
+			fmt.Fprintln(stderr, "batch takes at least one NAME")
+			return 2
+		}
+		var results []*countries.BatchSearchResult
+		results, err = c.BatchSearch(ctx, positional...)
+		if err == nil {
+			err = format.batch(stdout, results)
+		}
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n", command)
+		flags.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, status.Convert(err).Message())
+		return 1
+	}
+	return 0
+}
+
+// parseInterspersed parses flags appearing anywhere in args and returns the other arguments
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// lookupRequest builds a Lookup request from the single lookup flag that was set
+func lookupRequest(lookup map[string]*string) (*countries.LookupRequest, error) {
+	request := &countries.LookupRequest{}
+	set := 0
+	for field, value := range lookup {
+		if *value == "" {
+			continue
+		}
+		set++
+		switch field {
+		case "code":
+			request.By = &countries.LookupRequest_Code{Code: *value}
+		case "currency":
+			request.By = &countries.LookupRequest_Currency{Currency: *value}
+		case "capital":
+			request.By = &countries.LookupRequest_Capital{Capital: *value}
+		case "region":
+			request.By = &countries.LookupRequest_Region{Region: *value}
+		case "subregion":
+			request.By = &countries.LookupRequest_Subregion{Subregion: *value}
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("lookup takes exactly one of --code, --currency, --capital, --region or --subregion")
+	}
+	return request, nil
+}
+
+// loadCA reads a PEM CA certificate into a pool
+func loadCA(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+func envOr(name, fallback string) string {
+	if value, ok := os.LookupEnv(name); ok {
+		return value
+	}
+	return fallback
+}
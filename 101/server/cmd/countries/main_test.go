@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServer knows Brazil only
+type fakeServer struct {
+	countries.UnimplementedCountryServer
+}
+
+var brazil = &countries.CountryResponse{
+	Name:       "Brazil",
+	Alpha2Code: "BR",
+	Alpha3Code: "BRA",
+	Capital:    "Brasília",
+	Region:     "Americas",
+	Population: 212559409,
+	Currencies: []*countries.Currencies{{Code: "BRL", Name: "Brazilian real", Symbol: "R$"}},
+}
+
+func (*fakeServer) Search(ctx context.Context, request *countries.CountryRequest) (*countries.CountryResponse, error) {
+	if !strings.EqualFold(request.Name, "brazil") {
+		return nil, status.Errorf(codes.NotFound, "country %s not found", request.Name)
+	}
+	return brazil, nil
+}
+
+func (*fakeServer) Lookup(request *countries.LookupRequest, stream countries.Country_LookupServer) error {
+	if request.GetCurrency() != "BRL" {
+		return status.Error(codes.NotFound, "no country matches")
+	}
+	return stream.Send(brazil)
+}
+
+func serveFake(t *testing.T) string {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	countries.RegisterCountryServer(grpcServer, &fakeServer{})
+	go grpcServer.Serve(listen)
+	t.Cleanup(grpcServer.Stop)
+	return listen.Addr().String()
+}
+
+func TestRun(t *testing.T) {
+	addr := serveFake(t)
+
+	tests := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{
+			args: []string{"search", "brazil", "--output", "json"},
+			want: `{
+  "name": "Brazil",
+  "alpha2Code": "BR",
+  "capital": "Brasília",
+  "population": 212559409,
+  "currencies": [
+    {
+      "code": "BRL",
+      "name": "Brazilian real",
+      "symbol": "R$"
+    }
+  ],
+  "region": "Americas",
+  "alpha3Code": "BRA"
+}
+`,
+		},
+		{
+			args: []string{"lookup", "--currency", "BRL", "--output", "yaml"},
+			want: `- name: Brazil
+  alpha2Code: BR
+  capital: Brasília
+  population: 212559409
+  currencies:
+    - code: BRL
+      name: Brazilian real
+      symbol: R$
+  region: Americas
+  alpha3Code: BRA
+`,
+		},
+		{
+			args: []string{"lookup", "--currency", "BRL", "--output", "json"},
+			want: `[
+  {
+    "name": "Brazil",
+    "alpha2Code": "BR",
+    "capital": "Brasília",
+    "population": 212559409,
+    "currencies": [
+      {
+        "code": "BRL",
+        "name": "Brazilian real",
+        "symbol": "R$"
+      }
+    ],
+    "region": "Americas",
+    "alpha3Code": "BRA"
+  }
+]
+`,
+		},
+		{
+			args: []string{"--output", "table", "search", "Brazil"},
+			code: 2,
+		},
+		{
+			args: []string{"search", "Brazil"},
+			want: `NAME    ALPHA2  ALPHA3  CAPITAL   REGION    POPULATION  CURRENCIES
+Brazil  BR      BRA     Brasília  Americas  212559409   BRL
+`,
+		},
+		{
+			args: []string{"search", "atlantis"},
+			code: 1,
+		},
+		{
+			args: []string{"lookup", "--currency", "BRL", "--region", "Americas"},
+			code: 2,
+		},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(append(test.args, "--addr", addr), &stdout, &stderr)
+		if code != test.code {
+			t.Errorf("run(%v) = %d, want %d (%s)", test.args, code, test.code, stderr.String())
+			continue
+		}
+		if stdout.String() != test.want {
+			t.Errorf("run(%v) printed\n%s\nwant\n%s", test.args, stdout.String(), test.want)
+		}
+	}
+}
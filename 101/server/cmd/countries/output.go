@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"server/countries"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// format writes command results in one output format
+type format struct {
+	country   func(w io.Writer, c *countries.CountryResponse) error
+	countries func(w io.Writer, data []*countries.CountryResponse) error
+	batch     func(w io.Writer, results []*countries.BatchSearchResult) error
+}
+
+var formats = map[string]format{
+	"json": {
+		country: func(w io.Writer, c *countries.CountryResponse) error {
+			return writeJSON(w, c)
+		},
+		countries: func(w io.Writer, data []*countries.CountryResponse) error {
+			return writeJSON(w, countryMessages(data))
+		},
+		batch: func(w io.Writer, results []*countries.BatchSearchResult) error {
+			return writeJSON(w, resultMessages(results))
+		},
+	},
+	"yaml": {
+		country: func(w io.Writer, c *countries.CountryResponse) error {
+			return writeYAML(w, c)
+		},
+		countries: func(w io.Writer, data []*countries.CountryResponse) error {
+			return writeYAML(w, countryMessages(data))
+		},
+		batch: func(w io.Writer, results []*countries.BatchSearchResult) error {
+			return writeYAML(w, resultMessages(results))
+		},
+	},
+	"table": {
+		country: func(w io.Writer, c *countries.CountryResponse) error {
+			return writeCountryTable(w, []*countries.CountryResponse{c})
+		},
+		countries: writeCountryTable,
+		batch:     writeBatchTable,
+	},
+}
+
+// countryMessages and resultMessages let lists be marshaled as JSON arrays
+func countryMessages(data []*countries.CountryResponse) []proto.Message {
+	out := make([]proto.Message, len(data))
+	for i, c := range data {
+		out[i] = c
+	}
+	return out
+}
+
+func resultMessages(results []*countries.BatchSearchResult) []proto.Message {
+	out := make([]proto.Message, len(results))
+	for i, result := range results {
+		out[i] = result
+	}
+	return out
+}
+
+// marshalJSON renders a message, or a list of them as a JSON array,
+// keeping the proto field names
+func marshalJSON(v interface{}) ([]byte, error) {
+	node, err := toNode(v)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := writeNodeJSON(&out, node); err != nil {
+		return nil, err
+	}
+	var indented bytes.Buffer
+	err = json.Indent(&indented, out.Bytes(), "", "  ")
+	return indented.Bytes(), err
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	out, err := marshalJSON(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
+
+// writeYAML renders the JSON form as block YAML, which keeps the field order
+func writeYAML(w io.Writer, v interface{}) error {
+	node, err := toNode(v)
+	if err != nil {
+		return err
+	}
+	blockStyle(node)
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// toNode parses the JSON form of a message, or of a list of them, into a
+// YAML node. The JSON mapping of proto3 renders 64-bit integers as strings,
+// which the command line shows as numbers again.
+func toNode(v interface{}) (*yaml.Node, error) {
+	if m, ok := v.(proto.Message); ok {
+		out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(out, &doc); err != nil {
+			return nil, err
+		}
+		node := doc.Content[0]
+		retagIntegers(node, m.ProtoReflect().Descriptor())
+		return node, nil
+	}
+	list := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for _, m := range v.([]proto.Message) {
+		item, err := toNode(m)
+		if err != nil {
+			return nil, err
+		}
+		list.Content = append(list.Content, item)
+	}
+	return list, nil
+}
+
+// retagIntegers tags the 64-bit integers of the JSON form of a message
+// as integers rather than strings
+func retagIntegers(node *yaml.Node, md protoreflect.MessageDescriptor) {
+	// well-known types like Any have JSON forms of their own
+	if node.Kind != yaml.MappingNode || md.FullName().Parent() == "google.protobuf" {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fd := md.Fields().ByName(protoreflect.Name(node.Content[i].Value))
+		if fd == nil {
+			continue
+		}
+		value := node.Content[i+1]
+		switch {
+		case fd.IsMap():
+			for j := 1; j < len(value.Content); j += 2 {
+				retagValue(value.Content[j], fd.MapValue())
+			}
+		case fd.IsList():
+			for _, item := range value.Content {
+				retagValue(item, fd)
+			}
+		default:
+			retagValue(value, fd)
+		}
+	}
+}
+
+// retagValue retags a single value of the field
+func retagValue(node *yaml.Node, fd protoreflect.FieldDescriptor) {
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if node.Kind == yaml.ScalarNode {
+			node.Tag = "!!int"
+			node.Style = 0
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		retagIntegers(node, fd.Message())
+	}
+}
+
+// writeNodeJSON writes a node parsed from JSON back as compact JSON
+func writeNodeJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.MappingNode, yaml.SequenceNode:
+		open, end := "[", "]"
+		if node.Kind == yaml.MappingNode {
+			open, end = "{", "}"
+		}
+		buf.WriteString(open)
+		for i, child := range node.Content {
+			switch {
+			case i == 0:
+			case node.Kind == yaml.MappingNode && i%2 == 1:
+				buf.WriteString(":")
+			default:
+				buf.WriteString(",")
+			}
+			if err := writeNodeJSON(buf, child); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(end)
+	case yaml.ScalarNode:
+		if node.Tag != "!!str" {
+			buf.WriteString(node.Value)
+			return nil
+		}
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(node.Value); err != nil {
+			return err
+		}
+		// Encode ends the string with a newline
+		buf.Truncate(buf.Len() - 1)
+	default:
+		return fmt.Errorf("unexpected YAML node kind %d", node.Kind)
+	}
+	return nil
+}
+
+// blockStyle drops the flow and quoting styles JSON parsed into
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func writeCountryTable(w io.Writer, data []*countries.CountryResponse) error {
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "NAME\tALPHA2\tALPHA3\tCAPITAL\tREGION\tPOPULATION\tCURRENCIES")
+	for _, c := range data {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			c.Name, c.Alpha2Code, c.Alpha3Code, c.Capital, c.Region, c.Population, currencyCodes(c))
+	}
+	return table.Flush()
+}
+
+func writeBatchTable(w io.Writer, results []*countries.BatchSearchResult) error {
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "QUERY\tNAME\tALPHA2\tCAPITAL\tERROR")
+	for _, result := range results {
+		if c := result.GetCountry(); c != nil {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t\n", result.Name, c.Name, c.Alpha2Code, c.Capital)
+		} else {
+			fmt.Fprintf(table, "%s\t\t\t\t%s\n", result.Name, status.FromProto(result.GetError()).Message())
+		}
+	}
+	return table.Flush()
+}
+
+func currencyCodes(c *countries.CountryResponse) string {
+	codes := make([]string, len(c.Currencies))
+	for i, currency := range c.Currencies {
+		codes[i] = currency.Code
+	}
+	return strings.Join(codes, ",")
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix prefixes the environment variable of every flag,
+// e.g. -upstream-timeout is read from COUNTRY_UPSTREAM_TIMEOUT
+const envPrefix = "COUNTRY_"
+
+// Config holds everything the Country server can be configured with.
+// Values come from defaults, then the YAML file, then the environment
+// and finally the command line flags, each overriding the previous ones.
+type Config struct {
+	Listen           string          `yaml:"listen"`
+	Source           string          `yaml:"source"`
+	DataFile         string          `yaml:"data"`
+	Metrics          string          `yaml:"metrics"`
+	Gateway          string          `yaml:"gateway"`
+	BatchConcurrency int             `yaml:"batch_concurrency"`
+	ShutdownTimeout  time.Duration   `yaml:"shutdown_timeout"`
+	HealthInterval   time.Duration   `yaml:"health_interval"`
+	Upstream         UpstreamConfig  `yaml:"upstream"`
+	Providers        ProviderList    `yaml:"providers"`
+	ProviderTimeout  time.Duration   `yaml:"provider_timeout"`
+	Snapshot         SnapshotConfig  `yaml:"snapshot"`
+	Cache            CacheConfig     `yaml:"cache"`
+	Retry            RetryOptions    `yaml:"retry"`
+	Breaker          BreakerOptions  `yaml:"breaker"`
+	RateLimit        RateLimitConfig `yaml:"rate_limit"`
+	TLS              TLSConfig       `yaml:"tls"`
+
+	// PrintConfig prints the resulting configuration instead of serving
+	PrintConfig bool `yaml:"-"`
+}
+
+// UpstreamConfig locates the restcountries API and tunes the client for it
+type UpstreamConfig struct {
+	URL         string `yaml:"url"`
+	HTTPOptions `yaml:",inline"`
+}
+
+// ProviderConfig is one of the providers the aggregate source merges,
+// Kind is v2 or v3 for a restcountries API at URL, or file for Data
+type ProviderConfig struct {
+	Name string `yaml:"name"`
+	Kind string `yaml:"kind"`
+	URL  string `yaml:"url,omitempty"`
+	Data string `yaml:"data,omitempty"`
+}
+
+// ProviderList are the aggregated providers, primary first. As a flag
+// it reads "restcountries=v2:https://restcountries.com/v2,local=file:countries.json".
+type ProviderList []ProviderConfig
+
+func (l ProviderList) String() string {
+	providers := make([]string, len(l))
+	for i, p := range l {
+		location := p.URL
+		if p.Kind == "file" {
+			location = p.Data
+		}
+		providers[i] = p.Name + "=" + p.Kind + ":" + location
+	}
+	return strings.Join(providers, ",")
+}
+
+// Set parses the flag form of the providers, replacing the current ones
+func (l *ProviderList) Set(value string) error {
+	var providers ProviderList
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		nameAndKind := strings.SplitN(item, ":", 2)
+		parts := strings.SplitN(nameAndKind[0], "=", 2)
+		if len(nameAndKind) != 2 || len(parts) != 2 {
+			return fmt.Errorf("%q is not name=kind:location", item)
+		}
+		provider := ProviderConfig{Name: parts[0], Kind: parts[1], URL: nameAndKind[1]}
+		if provider.Kind == "file" {
+			provider.URL, provider.Data = "", nameAndKind[1]
+		}
+		providers = append(providers, provider)
+	}
+	*l = providers
+	return nil
+}
+
+// SnapshotConfig tells the snapshot source where to load the dataset
+// from and how often, a zero Refresh loads it only at startup
+type SnapshotConfig struct {
+	From    string        `yaml:"from"`
+	Refresh time.Duration `yaml:"refresh"`
+}
+
+// CacheConfig sizes the Search cache, a zero Size disables it
+type CacheConfig struct {
+	Size        int           `yaml:"size"`
+	TTL         time.Duration `yaml:"ttl"`
+	NegativeTTL time.Duration `yaml:"negative_ttl"`
+}
+
+// RateLimitConfig limits the calls of every client, per method
+type RateLimitConfig struct {
+	RateLimit `yaml:",inline"`
+	Methods   MethodLimits `yaml:"methods"`
+}
+
+// TLSConfig enables TLS on the gRPC listener when a certificate is set
+type TLSConfig struct {
+	CertFile string `yaml:"cert"`
+	KeyFile  string `yaml:"key"`
+}
+
+// DefaultConfig returns the configuration used when nothing is set
+func DefaultConfig() Config {
+	return Config{
+		Listen:           "0.0.0.0:3000",
+		Source:           "http",
+		DataFile:         "countries.json",
+		BatchConcurrency: defaultBatchConcurrency,
+		ShutdownTimeout:  15 * time.Second,
+		HealthInterval:   30 * time.Second,
+		Upstream: UpstreamConfig{
+			URL:         "https://restcountries.com/v2",
+			HTTPOptions: DefaultHTTPOptions,
+		},
+		Providers: ProviderList{
+			{Name: "restcountries", Kind: "v2", URL: "https://restcountries.com/v2"},
+			{Name: "file", Kind: "file", Data: "countries.json"},
+		},
+		ProviderTimeout: 3 * time.Second,
+		Snapshot: SnapshotConfig{
+			From:    "http",
+			Refresh: 24 * time.Hour,
+		},
+		Cache: CacheConfig{
+			Size:        1000,
+			TTL:         5 * time.Minute,
+			NegativeTTL: time.Minute,
+		},
+		Retry:   DefaultRetryOptions,
+		Breaker: DefaultBreakerOptions,
+		// batches cost a token per name, like searching them one by one
+		RateLimit: RateLimitConfig{RateLimit: RateLimit{Rate: 10, Burst: 20}},
+	}
+}
+
+// LoadConfig builds the configuration from the YAML file, the environment and args
+func LoadConfig(args []string) (Config, error) {
+	cfg := DefaultConfig()
+	var configFile string
+	flags := flag.NewFlagSet("server", flag.ContinueOnError)
+	flags.StringVar(&configFile, "config", os.Getenv(envPrefix+"CONFIG"), "optional YAML configuration file")
+	flags.BoolVar(&cfg.PrintConfig, "print-config", false, "print the resulting configuration and exit")
+	cfg.bind(flags)
+	if err := flags.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	// remember the flags given on the command line, the file and the
+	// environment overwrite the values they were parsed into
+	set := make(map[string]string)
+	flags.Visit(func(f *flag.Flag) {
+		set[f.Name] = f.Value.String()
+	})
+
+	if configFile != "" {
+		data, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return cfg, err
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("could not parse %s: %v", configFile, err)
+		}
+	}
+
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		value, ok := set[f.Name]
+		if !ok {
+			value, ok = os.LookupEnv(envName(f.Name))
+		}
+		if ok && err == nil {
+			if setErr := f.Value.Set(value); setErr != nil {
+				err = fmt.Errorf("invalid value %q for %s: %v", value, f.Name, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// bind registers a flag for every setting
+func (cfg *Config) bind(flags *flag.FlagSet) {
+	flags.StringVar(&cfg.Listen, "listen", cfg.Listen, "address the gRPC server listens on")
+	flags.StringVar(&cfg.Source, "source", cfg.Source, "country data source: http, file, snapshot or aggregate")
+	flags.StringVar(&cfg.DataFile, "data", cfg.DataFile, "JSON dump used by the file source")
+	flags.StringVar(&cfg.Metrics, "metrics", cfg.Metrics, "address serving expvar metrics on /debug/vars, empty disables it")
+	flags.StringVar(&cfg.Gateway, "gateway", cfg.Gateway, "address serving the REST/JSON gateway, empty disables it")
+	flags.IntVar(&cfg.BatchConcurrency, "batch-concurrency", cfg.BatchConcurrency, "upstream calls a single batch search makes at once")
+	flags.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "how long in-flight calls may drain on shutdown")
+	flags.DurationVar(&cfg.HealthInterval, "health-interval", cfg.HealthInterval, "how often the upstream reachability is checked")
+
+	upstream := &cfg.Upstream
+	flags.StringVar(&upstream.URL, "upstream", upstream.URL, "restcountries API base URL")
+	flags.StringVar(&upstream.API, "upstream-api", upstream.API, "restcountries API version: v2 or v3")
+	flags.DurationVar(&upstream.Timeout, "upstream-timeout", upstream.Timeout, "max duration of an upstream request")
+	flags.DurationVar(&upstream.DialTimeout, "upstream-dial-timeout", upstream.DialTimeout, "max duration to connect to the upstream")
+	flags.IntVar(&upstream.MaxIdleConns, "upstream-max-idle-conns", upstream.MaxIdleConns, "idle upstream connections kept in the pool")
+	flags.IntVar(&upstream.MaxConnsPerHost, "upstream-max-conns", upstream.MaxConnsPerHost, "max upstream connections, 0 means no limit")
+	flags.DurationVar(&upstream.IdleConnTimeout, "upstream-idle-timeout", upstream.IdleConnTimeout, "how long idle upstream connections are kept")
+	flags.DurationVar(&upstream.NamesRefresh, "upstream-names-refresh", upstream.NamesRefresh, "how often the country names suggested for unmatched searches are fetched, 0 disables suggestions")
+
+	flags.Var(&cfg.Providers, "providers", "providers merged by the aggregate source, primary first, as name=kind:location")
+	flags.DurationVar(&cfg.ProviderTimeout, "provider-timeout", cfg.ProviderTimeout, "max duration a provider of the aggregate source may take to answer, 0 means no limit")
+
+	flags.StringVar(&cfg.Snapshot.From, "snapshot-from", cfg.Snapshot.From, "where the snapshot source loads the dataset from: http or file")
+	flags.DurationVar(&cfg.Snapshot.Refresh, "snapshot-refresh", cfg.Snapshot.Refresh, "how often the snapshot is reloaded, 0 disables it")
+
+	flags.IntVar(&cfg.Cache.Size, "cache-size", cfg.Cache.Size, "max number of cached names, 0 disables the cache")
+	flags.DurationVar(&cfg.Cache.TTL, "cache-ttl", cfg.Cache.TTL, "how long found countries stay cached")
+	flags.DurationVar(&cfg.Cache.NegativeTTL, "cache-negative-ttl", cfg.Cache.NegativeTTL, "how long unknown names stay cached")
+
+	flags.IntVar(&cfg.Retry.MaxAttempts, "retry-attempts", cfg.Retry.MaxAttempts, "tries per upstream call, 1 disables retries")
+	flags.DurationVar(&cfg.Retry.BaseDelay, "retry-base-delay", cfg.Retry.BaseDelay, "backoff before the first retry")
+	flags.DurationVar(&cfg.Retry.MaxDelay, "retry-max-delay", cfg.Retry.MaxDelay, "max backoff between retries")
+
+	flags.IntVar(&cfg.Breaker.FailureThreshold, "breaker-failures", cfg.Breaker.FailureThreshold, "consecutive upstream failures opening the circuit")
+	flags.DurationVar(&cfg.Breaker.OpenTimeout, "breaker-open-timeout", cfg.Breaker.OpenTimeout, "how long the circuit stays open before probing")
+	flags.IntVar(&cfg.Breaker.HalfOpenProbes, "breaker-probes", cfg.Breaker.HalfOpenProbes, "concurrent calls probing a half-open circuit")
+
+	flags.Float64Var(&cfg.RateLimit.Rate, "rate-limit", cfg.RateLimit.Rate, "calls per second allowed to each client and method, 0 disables rate limiting")
+	flags.IntVar(&cfg.RateLimit.Burst, "rate-burst", cfg.RateLimit.Burst, "calls a client may make at once before being limited")
+	flags.Var(&cfg.RateLimit.Methods, "rate-limit-methods", "per method limits overriding the default ones, e.g. BatchSearch=1:5")
+
+	flags.StringVar(&cfg.TLS.CertFile, "tls-cert", cfg.TLS.CertFile, "TLS certificate file, enables TLS when set")
+	flags.StringVar(&cfg.TLS.KeyFile, "tls-key", cfg.TLS.KeyFile, "TLS private key file")
+}
+
+// validateProviders checks the providers of the aggregate source
+func (cfg Config) validateProviders(check func(ok bool, format string, args ...interface{})) {
+	check(len(cfg.Providers) > 0, "providers: at least one is required by the aggregate source")
+	check(cfg.ProviderTimeout >= 0, "provider_timeout: must not be negative")
+	names := make(map[string]bool)
+	for i, p := range cfg.Providers {
+		check(p.Name != "" && !names[p.Name], "providers[%d].name: %q is empty or used twice", i, p.Name)
+		names[p.Name] = true
+		switch p.Kind {
+		case "v2", "v3":
+			u, err := url.Parse(p.URL)
+			check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "",
+				"providers[%d].url: %q is not an http(s) URL", i, p.URL)
+		case "file":
+			check(p.Data != "", "providers[%d].data: a file is required", i)
+		default:
+			check(false, "providers[%d].kind: %q is neither v2, v3 nor file", i, p.Kind)
+		}
+	}
+}
+
+// envName is the environment variable overriding the given flag
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// Validate reports every invalid setting at once
+func (cfg Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	_, _, err := net.SplitHostPort(cfg.Listen)
+	check(err == nil, "listen: %q is not a host:port address", cfg.Listen)
+	if cfg.Metrics != "" {
+		_, _, err := net.SplitHostPort(cfg.Metrics)
+		check(err == nil, "metrics: %q is not a host:port address", cfg.Metrics)
+	}
+	if cfg.Gateway != "" {
+		_, _, err := net.SplitHostPort(cfg.Gateway)
+		check(err == nil, "gateway: %q is not a host:port address", cfg.Gateway)
+	}
+	dataFrom := cfg.Source
+	if cfg.Source == "aggregate" {
+		dataFrom = ""
+		cfg.validateProviders(check)
+	}
+	if cfg.Source == "snapshot" {
+		dataFrom = cfg.Snapshot.From
+		check(cfg.Snapshot.Refresh >= 0, "snapshot.refresh: must not be negative")
+	}
+	switch dataFrom {
+	case "http":
+		u, err := url.Parse(cfg.Upstream.URL)
+		check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "",
+			"upstream.url: %q is not an http(s) URL", cfg.Upstream.URL)
+	case "file":
+		check(cfg.DataFile != "", "data: a file is required by the %s source", cfg.Source)
+	case "":
+	default:
+		if cfg.Source == "snapshot" {
+			check(false, "snapshot.from: %q is neither http nor file", cfg.Snapshot.From)
+		} else {
+			check(false, "source: %q is neither http, file, snapshot nor aggregate", cfg.Source)
+		}
+	}
+	check(cfg.Upstream.API == "v2" || cfg.Upstream.API == "v3", "upstream.api: %q is neither v2 nor v3", cfg.Upstream.API)
+	check(cfg.BatchConcurrency > 0, "batch_concurrency: must be positive")
+	check(cfg.ShutdownTimeout >= 0, "shutdown_timeout: must not be negative")
+	check(cfg.HealthInterval > 0, "health_interval: must be positive")
+
+	check(cfg.Upstream.Timeout > 0, "upstream.timeout: must be positive")
+	check(cfg.Upstream.DialTimeout > 0, "upstream.dial_timeout: must be positive")
+	check(cfg.Upstream.MaxIdleConns >= 0, "upstream.max_idle_conns: must not be negative")
+	check(cfg.Upstream.MaxConnsPerHost >= 0, "upstream.max_conns: must not be negative")
+	check(cfg.Upstream.IdleConnTimeout >= 0, "upstream.idle_timeout: must not be negative")
+	check(cfg.Upstream.NamesRefresh >= 0, "upstream.names_refresh: must not be negative")
+
+	check(cfg.Cache.Size >= 0, "cache.size: must not be negative")
+	check(cfg.Cache.TTL >= 0, "cache.ttl: must not be negative")
+	check(cfg.Cache.NegativeTTL >= 0, "cache.negative_ttl: must not be negative")
+
+	check(cfg.Retry.MaxAttempts >= 1, "retry.max_attempts: must be at least 1")
+	check(cfg.Retry.BaseDelay >= 0, "retry.base_delay: must not be negative")
+	check(cfg.Retry.MaxDelay >= cfg.Retry.BaseDelay, "retry.max_delay: must not be below retry.base_delay")
+
+	check(cfg.Breaker.FailureThreshold >= 1, "breaker.failure_threshold: must be at least 1")
+	check(cfg.Breaker.OpenTimeout > 0, "breaker.open_timeout: must be positive")
+	check(cfg.Breaker.HalfOpenProbes >= 1, "breaker.half_open_probes: must be at least 1")
+
+	for method, limit := range cfg.RateLimit.Methods {
+		check(limit.Rate >= 0, "rate_limit.methods.%s.rate: must not be negative", method)
+		check(limit.Rate == 0 || limit.Burst >= 1, "rate_limit.methods.%s.burst: must be at least 1", method)
+	}
+	check(cfg.RateLimit.Rate >= 0, "rate_limit.rate: must not be negative")
+	check(cfg.RateLimit.Rate == 0 || cfg.RateLimit.Burst >= 1, "rate_limit.burst: must be at least 1")
+
+	check((cfg.TLS.CertFile == "") == (cfg.TLS.KeyFile == ""), "tls: cert and key must be set together")
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "server.yaml")
+	yaml := `
+listen: 127.0.0.1:4000
+upstream:
+  url: http://countries.internal/v2
+  timeout: 2s
+cache:
+  size: 10
+  ttl: 1m
+`
+	if err := ioutil.WriteFile(file, []byte(yaml), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("COUNTRY_CACHE_SIZE", "20")
+	t.Setenv("COUNTRY_UPSTREAM_TIMEOUT", "3s")
+
+	cfg, err := LoadConfig([]string{"-config", file, "-upstream-timeout", "4s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Listen != "127.0.0.1:4000" || cfg.Upstream.URL != "http://countries.internal/v2" {
+		t.Errorf("file values not applied: %+v", cfg)
+	}
+	if cfg.Cache.Size != 20 || cfg.Cache.TTL != time.Minute {
+		t.Errorf("environment should override the file: %+v", cfg.Cache)
+	}
+	if cfg.Upstream.Timeout != 4*time.Second {
+		t.Errorf("flags should override the environment, got %v", cfg.Upstream.Timeout)
+	}
+	if cfg.Retry != DefaultRetryOptions {
+		t.Errorf("unset values should keep their default, got %+v", cfg.Retry)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	_, err := LoadConfig([]string{"-source", "ftp", "-cache-size", "-1", "-tls-key", "server.key"})
+	if err == nil {
+		t.Fatal("expected an invalid configuration")
+	}
+	for _, problem := range []string{"source", "cache.size", "tls"} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("expected %s to be reported in %v", problem, err)
+		}
+	}
+}
@@ -8,9 +8,11 @@ package countries
 
 import (
 	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -71,6 +73,427 @@ func (x *CountryRequest) GetName() string {
 	return ""
 }
 
+type SearchAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// only return countries whose name is exactly the requested one
+	ExactMatch bool `protobuf:"varint,2,opt,name=exactMatch,proto3" json:"exactMatch,omitempty"`
+	// stop after this many countries, 0 means no limit
+	MaxResults int32 `protobuf:"varint,3,opt,name=maxResults,proto3" json:"maxResults,omitempty"`
+}
+
+func (x *SearchAllRequest) Reset() {
+	*x = SearchAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllRequest) ProtoMessage() {}
+
+func (x *SearchAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllRequest.ProtoReflect.Descriptor instead.
+func (*SearchAllRequest) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchAllRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchAllRequest) GetExactMatch() bool {
+	if x != nil {
+		return x.ExactMatch
+	}
+	return false
+}
+
+func (x *SearchAllRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+type LookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to By:
+	//	*LookupRequest_Code
+	//	*LookupRequest_Currency
+	//	*LookupRequest_Capital
+	//	*LookupRequest_Region
+	//	*LookupRequest_Subregion
+	By isLookupRequest_By `protobuf_oneof:"by"`
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *LookupRequest) GetBy() isLookupRequest_By {
+	if m != nil {
+		return m.By
+	}
+	return nil
+}
+
+func (x *LookupRequest) GetCode() string {
+	if x, ok := x.GetBy().(*LookupRequest_Code); ok {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LookupRequest) GetCurrency() string {
+	if x, ok := x.GetBy().(*LookupRequest_Currency); ok {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LookupRequest) GetCapital() string {
+	if x, ok := x.GetBy().(*LookupRequest_Capital); ok {
+		return x.Capital
+	}
+	return ""
+}
+
+func (x *LookupRequest) GetRegion() string {
+	if x, ok := x.GetBy().(*LookupRequest_Region); ok {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *LookupRequest) GetSubregion() string {
+	if x, ok := x.GetBy().(*LookupRequest_Subregion); ok {
+		return x.Subregion
+	}
+	return ""
+}
+
+type isLookupRequest_By interface {
+	isLookupRequest_By()
+}
+
+type LookupRequest_Code struct {
+	// alpha-2 or alpha-3 ISO 3166-1 code
+	Code string `protobuf:"bytes,1,opt,name=code,proto3,oneof"`
+}
+
+type LookupRequest_Currency struct {
+	// ISO 4217 currency code
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3,oneof"`
+}
+
+type LookupRequest_Capital struct {
+	// capital city, partial names match
+	Capital string `protobuf:"bytes,3,opt,name=capital,proto3,oneof"`
+}
+
+type LookupRequest_Region struct {
+	Region string `protobuf:"bytes,4,opt,name=region,proto3,oneof"`
+}
+
+type LookupRequest_Subregion struct {
+	Subregion string `protobuf:"bytes,5,opt,name=subregion,proto3,oneof"`
+}
+
+func (*LookupRequest_Code) isLookupRequest_By() {}
+
+func (*LookupRequest_Currency) isLookupRequest_By() {}
+
+func (*LookupRequest_Capital) isLookupRequest_By() {}
+
+func (*LookupRequest_Region) isLookupRequest_By() {}
+
+func (*LookupRequest_Subregion) isLookupRequest_By() {}
+
+type BatchSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BatchSearchRequest) Reset() {
+	*x = BatchSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSearchRequest) ProtoMessage() {}
+
+func (x *BatchSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSearchRequest.ProtoReflect.Descriptor instead.
+func (*BatchSearchRequest) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchSearchRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Result:
+	//	*BatchSearchResult_Country
+	//	*BatchSearchResult_Error
+	Result isBatchSearchResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *BatchSearchResult) Reset() {
+	*x = BatchSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSearchResult) ProtoMessage() {}
+
+func (x *BatchSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSearchResult.ProtoReflect.Descriptor instead.
+func (*BatchSearchResult) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchSearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *BatchSearchResult) GetResult() isBatchSearchResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *BatchSearchResult) GetCountry() *CountryResponse {
+	if x, ok := x.GetResult().(*BatchSearchResult_Country); ok {
+		return x.Country
+	}
+	return nil
+}
+
+func (x *BatchSearchResult) GetError() *status.Status {
+	if x, ok := x.GetResult().(*BatchSearchResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isBatchSearchResult_Result interface {
+	isBatchSearchResult_Result()
+}
+
+type BatchSearchResult_Country struct {
+	Country *CountryResponse `protobuf:"bytes,2,opt,name=country,proto3,oneof"`
+}
+
+type BatchSearchResult_Error struct {
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchSearchResult_Country) isBatchSearchResult_Result() {}
+
+func (*BatchSearchResult_Error) isBatchSearchResult_Result() {}
+
+type BatchSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchSearchResponse) Reset() {
+	*x = BatchSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSearchResponse) ProtoMessage() {}
+
+func (x *BatchSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSearchResponse.ProtoReflect.Descriptor instead.
+func (*BatchSearchResponse) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchSearchResponse) GetResults() []*BatchSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SearchSuggestions is attached to the NOT_FOUND error of a search and
+// lists known country names close to the requested one, closest first
+type SearchSuggestions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *SearchSuggestions) Reset() {
+	*x = SearchSuggestions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSuggestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestions) ProtoMessage() {}
+
+func (x *SearchSuggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestions.ProtoReflect.Descriptor instead.
+func (*SearchSuggestions) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchSuggestions) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
 type Currencies struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -84,7 +507,7 @@ type Currencies struct {
 func (x *Currencies) Reset() {
 	*x = Currencies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_countries_proto_msgTypes[1]
+		mi := &file_countries_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -97,7 +520,74 @@ func (x *Currencies) String() string {
 func (*Currencies) ProtoMessage() {}
 
 func (x *Currencies) ProtoReflect() protoreflect.Message {
-	mi := &file_countries_proto_msgTypes[1]
+	mi := &file_countries_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currencies.ProtoReflect.Descriptor instead.
+func (*Currencies) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Currencies) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currencies) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Currencies) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type Languages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iso639_1   string `protobuf:"bytes,1,opt,name=iso639_1,json=iso6391,proto3" json:"iso639_1,omitempty"`
+	Iso639_2   string `protobuf:"bytes,2,opt,name=iso639_2,json=iso6392,proto3" json:"iso639_2,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NativeName string `protobuf:"bytes,4,opt,name=nativeName,proto3" json:"nativeName,omitempty"`
+	// set by restcountries v3 providers only, which give no ISO 639-1 or
+	// ISO 639-2 codes
+	Iso639_3 string `protobuf:"bytes,5,opt,name=iso639_3,json=iso6393,proto3" json:"iso639_3,omitempty"`
+}
+
+func (x *Languages) Reset() {
+	*x = Languages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_countries_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Languages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Languages) ProtoMessage() {}
+
+func (x *Languages) ProtoReflect() protoreflect.Message {
+	mi := &file_countries_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,28 +598,42 @@ func (x *Currencies) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Currencies.ProtoReflect.Descriptor instead.
-func (*Currencies) Descriptor() ([]byte, []int) {
-	return file_countries_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Languages.ProtoReflect.Descriptor instead.
+func (*Languages) Descriptor() ([]byte, []int) {
+	return file_countries_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Languages) GetIso639_1() string {
+	if x != nil {
+		return x.Iso639_1
+	}
+	return ""
 }
 
-func (x *Currencies) GetCode() string {
+func (x *Languages) GetIso639_2() string {
 	if x != nil {
-		return x.Code
+		return x.Iso639_2
 	}
 	return ""
 }
 
-func (x *Currencies) GetName() string {
+func (x *Languages) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Currencies) GetSymbol() string {
+func (x *Languages) GetNativeName() string {
 	if x != nil {
-		return x.Symbol
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *Languages) GetIso639_3() string {
+	if x != nil {
+		return x.Iso639_3
 	}
 	return ""
 }
@@ -139,19 +643,37 @@ type CountryResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alpha2Code string        `protobuf:"bytes,2,opt,name=alpha2Code,proto3" json:"alpha2Code,omitempty"`
-	Capital    string        `protobuf:"bytes,3,opt,name=capital,proto3" json:"capital,omitempty"`
-	Subregion  string        `protobuf:"bytes,4,opt,name=subregion,proto3" json:"subregion,omitempty"`
-	Population int32         `protobuf:"varint,5,opt,name=population,proto3" json:"population,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alpha2Code string `protobuf:"bytes,2,opt,name=alpha2Code,proto3" json:"alpha2Code,omitempty"`
+	Capital    string `protobuf:"bytes,3,opt,name=capital,proto3" json:"capital,omitempty"`
+	Subregion  string `protobuf:"bytes,4,opt,name=subregion,proto3" json:"subregion,omitempty"`
+	// Population is an int64, so the proto3 JSON mapping used by the REST
+	// gateway renders it as a string like "212559409", not as a number.
+	Population int64         `protobuf:"varint,5,opt,name=population,proto3" json:"population,omitempty"`
 	NativeName string        `protobuf:"bytes,6,opt,name=nativeName,proto3" json:"nativeName,omitempty"`
 	Currencies []*Currencies `protobuf:"bytes,7,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	Region     string        `protobuf:"bytes,8,opt,name=region,proto3" json:"region,omitempty"`
+	Alpha3Code string        `protobuf:"bytes,9,opt,name=alpha3Code,proto3" json:"alpha3Code,omitempty"`
+	Languages  []*Languages  `protobuf:"bytes,10,rep,name=languages,proto3" json:"languages,omitempty"`
+	// alpha-3 codes of the neighbouring countries
+	Borders   []string `protobuf:"bytes,11,rep,name=borders,proto3" json:"borders,omitempty"`
+	Timezones []string `protobuf:"bytes,12,rep,name=timezones,proto3" json:"timezones,omitempty"`
+	// in square kilometres
+	Area float64 `protobuf:"fixed64,13,opt,name=area,proto3" json:"area,omitempty"`
+	// latitude and longitude of the country centre
+	Latlng       []float64 `protobuf:"fixed64,14,rep,packed,name=latlng,proto3" json:"latlng,omitempty"`
+	CallingCodes []string  `protobuf:"bytes,15,rep,name=callingCodes,proto3" json:"callingCodes,omitempty"`
+	// URL of the flag image
+	Flag string `protobuf:"bytes,16,opt,name=flag,proto3" json:"flag,omitempty"`
+	// provider that supplied each field, keyed by field name, when the
+	// server aggregates several providers
+	Provenance map[string]string `protobuf:"bytes,17,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CountryResponse) Reset() {
 	*x = CountryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_countries_proto_msgTypes[2]
+		mi := &file_countries_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -164,7 +686,7 @@ func (x *CountryResponse) String() string {
 func (*CountryResponse) ProtoMessage() {}
 
 func (x *CountryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_countries_proto_msgTypes[2]
+	mi := &file_countries_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +699,7 @@ func (x *CountryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountryResponse.ProtoReflect.Descriptor instead.
 func (*CountryResponse) Descriptor() ([]byte, []int) {
-	return file_countries_proto_rawDescGZIP(), []int{2}
+	return file_countries_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CountryResponse) GetName() string {
@@ -208,7 +730,7 @@ func (x *CountryResponse) GetSubregion() string {
 	return ""
 }
 
-func (x *CountryResponse) GetPopulation() int32 {
+func (x *CountryResponse) GetPopulation() int64 {
 	if x != nil {
 		return x.Population
 	}
@@ -229,41 +751,212 @@ func (x *CountryResponse) GetCurrencies() []*Currencies {
 	return nil
 }
 
+func (x *CountryResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CountryResponse) GetAlpha3Code() string {
+	if x != nil {
+		return x.Alpha3Code
+	}
+	return ""
+}
+
+func (x *CountryResponse) GetLanguages() []*Languages {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *CountryResponse) GetBorders() []string {
+	if x != nil {
+		return x.Borders
+	}
+	return nil
+}
+
+func (x *CountryResponse) GetTimezones() []string {
+	if x != nil {
+		return x.Timezones
+	}
+	return nil
+}
+
+func (x *CountryResponse) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *CountryResponse) GetLatlng() []float64 {
+	if x != nil {
+		return x.Latlng
+	}
+	return nil
+}
+
+func (x *CountryResponse) GetCallingCodes() []string {
+	if x != nil {
+		return x.CallingCodes
+	}
+	return nil
+}
+
+func (x *CountryResponse) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *CountryResponse) GetProvenance() map[string]string {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
 var File_countries_proto protoreflect.FileDescriptor
 
 var file_countries_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x32, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x69,
-	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x69, 0x74,
-	0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x35, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x32, 0x4c, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x41, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x14, 0x5a, 0x12, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x12, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x24, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x69, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x61, 0x70, 0x69,
+	0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x0a,
+	0x02, 0x62, 0x79, 0x22, 0x2a, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x95, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x4c, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22,
+	0x90, 0x01, 0x0a, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x6f, 0x36, 0x33, 0x39, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x73, 0x6f, 0x36, 0x33, 0x39, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x6f, 0x36,
+	0x33, 0x39, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x36,
+	0x33, 0x39, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x6f, 0x36, 0x33,
+	0x39, 0x5f, 0x33, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x36, 0x33,
+	0x39, 0x33, 0x22, 0x87, 0x05, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61,
+	0x70, 0x69, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70,
+	0x69, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x6c, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x6c, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x12, 0x4a, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xf4, 0x03, 0x0a,
+	0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x6c, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
+	0x18, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12,
+	0x52, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x42, 0x14, 0x5a, 0x12, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -278,21 +971,43 @@ func file_countries_proto_rawDescGZIP() []byte {
 	return file_countries_proto_rawDescData
 }
 
-var file_countries_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_countries_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_countries_proto_goTypes = []interface{}{
-	(*CountryRequest)(nil),  // 0: countries.CountryRequest
-	(*Currencies)(nil),      // 1: countries.Currencies
-	(*CountryResponse)(nil), // 2: countries.CountryResponse
+	(*CountryRequest)(nil),      // 0: countries.CountryRequest
+	(*SearchAllRequest)(nil),    // 1: countries.SearchAllRequest
+	(*LookupRequest)(nil),       // 2: countries.LookupRequest
+	(*BatchSearchRequest)(nil),  // 3: countries.BatchSearchRequest
+	(*BatchSearchResult)(nil),   // 4: countries.BatchSearchResult
+	(*BatchSearchResponse)(nil), // 5: countries.BatchSearchResponse
+	(*SearchSuggestions)(nil),   // 6: countries.SearchSuggestions
+	(*Currencies)(nil),          // 7: countries.Currencies
+	(*Languages)(nil),           // 8: countries.Languages
+	(*CountryResponse)(nil),     // 9: countries.CountryResponse
+	nil,                         // 10: countries.CountryResponse.ProvenanceEntry
+	(*status.Status)(nil),       // 11: google.rpc.Status
 }
 var file_countries_proto_depIdxs = []int32{
-	1, // 0: countries.CountryResponse.currencies:type_name -> countries.Currencies
-	0, // 1: countries.Country.Search:input_type -> countries.CountryRequest
-	2, // 2: countries.Country.Search:output_type -> countries.CountryResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: countries.BatchSearchResult.country:type_name -> countries.CountryResponse
+	11, // 1: countries.BatchSearchResult.error:type_name -> google.rpc.Status
+	4,  // 2: countries.BatchSearchResponse.results:type_name -> countries.BatchSearchResult
+	7,  // 3: countries.CountryResponse.currencies:type_name -> countries.Currencies
+	8,  // 4: countries.CountryResponse.languages:type_name -> countries.Languages
+	10, // 5: countries.CountryResponse.provenance:type_name -> countries.CountryResponse.ProvenanceEntry
+	0,  // 6: countries.Country.Search:input_type -> countries.CountryRequest
+	1,  // 7: countries.Country.SearchAll:input_type -> countries.SearchAllRequest
+	2,  // 8: countries.Country.Lookup:input_type -> countries.LookupRequest
+	3,  // 9: countries.Country.BatchSearch:input_type -> countries.BatchSearchRequest
+	0,  // 10: countries.Country.BatchSearchStream:input_type -> countries.CountryRequest
+	9,  // 11: countries.Country.Search:output_type -> countries.CountryResponse
+	9,  // 12: countries.Country.SearchAll:output_type -> countries.CountryResponse
+	9,  // 13: countries.Country.Lookup:output_type -> countries.CountryResponse
+	5,  // 14: countries.Country.BatchSearch:output_type -> countries.BatchSearchResponse
+	4,  // 15: countries.Country.BatchSearchStream:output_type -> countries.BatchSearchResult
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_countries_proto_init() }
@@ -314,7 +1029,7 @@ func file_countries_proto_init() {
 			}
 		}
 		file_countries_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Currencies); i {
+			switch v := v.(*SearchAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -326,6 +1041,90 @@ func file_countries_proto_init() {
 			}
 		}
 		file_countries_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSuggestions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Currencies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Languages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_countries_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountryResponse); i {
 			case 0:
 				return &v.state
@@ -338,13 +1137,24 @@ func file_countries_proto_init() {
 			}
 		}
 	}
+	file_countries_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*LookupRequest_Code)(nil),
+		(*LookupRequest_Currency)(nil),
+		(*LookupRequest_Capital)(nil),
+		(*LookupRequest_Region)(nil),
+		(*LookupRequest_Subregion)(nil),
+	}
+	file_countries_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*BatchSearchResult_Country)(nil),
+		(*BatchSearchResult_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_countries_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -371,6 +1181,16 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CountryClient interface {
 	Search(ctx context.Context, in *CountryRequest, opts ...grpc.CallOption) (*CountryResponse, error)
+	// SearchAll streams every country whose name contains the requested one
+	SearchAll(ctx context.Context, in *SearchAllRequest, opts ...grpc.CallOption) (Country_SearchAllClient, error)
+	// Lookup streams every country matching one of the other country fields
+	Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (Country_LookupClient, error)
+	// BatchSearch searches many names at once, each distinct name is
+	// answered with either a country or the error searching it failed with
+	BatchSearch(ctx context.Context, in *BatchSearchRequest, opts ...grpc.CallOption) (*BatchSearchResponse, error)
+	// BatchSearchStream answers each distinct name as soon as it is found,
+	// in no particular order
+	BatchSearchStream(ctx context.Context, opts ...grpc.CallOption) (Country_BatchSearchStreamClient, error)
 }
 
 type countryClient struct {
@@ -390,9 +1210,123 @@ func (c *countryClient) Search(ctx context.Context, in *CountryRequest, opts ...
 	return out, nil
 }
 
+func (c *countryClient) SearchAll(ctx context.Context, in *SearchAllRequest, opts ...grpc.CallOption) (Country_SearchAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Country_serviceDesc.Streams[0], "/countries.Country/SearchAll", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &countrySearchAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Country_SearchAllClient interface {
+	Recv() (*CountryResponse, error)
+	grpc.ClientStream
+}
+
+type countrySearchAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *countrySearchAllClient) Recv() (*CountryResponse, error) {
+	m := new(CountryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *countryClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (Country_LookupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Country_serviceDesc.Streams[1], "/countries.Country/Lookup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &countryLookupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Country_LookupClient interface {
+	Recv() (*CountryResponse, error)
+	grpc.ClientStream
+}
+
+type countryLookupClient struct {
+	grpc.ClientStream
+}
+
+func (x *countryLookupClient) Recv() (*CountryResponse, error) {
+	m := new(CountryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *countryClient) BatchSearch(ctx context.Context, in *BatchSearchRequest, opts ...grpc.CallOption) (*BatchSearchResponse, error) {
+	out := new(BatchSearchResponse)
+	err := c.cc.Invoke(ctx, "/countries.Country/BatchSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *countryClient) BatchSearchStream(ctx context.Context, opts ...grpc.CallOption) (Country_BatchSearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Country_serviceDesc.Streams[2], "/countries.Country/BatchSearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &countryBatchSearchStreamClient{stream}
+	return x, nil
+}
+
+type Country_BatchSearchStreamClient interface {
+	Send(*CountryRequest) error
+	Recv() (*BatchSearchResult, error)
+	grpc.ClientStream
+}
+
+type countryBatchSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *countryBatchSearchStreamClient) Send(m *CountryRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *countryBatchSearchStreamClient) Recv() (*BatchSearchResult, error) {
+	m := new(BatchSearchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CountryServer is the server API for Country service.
 type CountryServer interface {
 	Search(context.Context, *CountryRequest) (*CountryResponse, error)
+	// SearchAll streams every country whose name contains the requested one
+	SearchAll(*SearchAllRequest, Country_SearchAllServer) error
+	// Lookup streams every country matching one of the other country fields
+	Lookup(*LookupRequest, Country_LookupServer) error
+	// BatchSearch searches many names at once, each distinct name is
+	// answered with either a country or the error searching it failed with
+	BatchSearch(context.Context, *BatchSearchRequest) (*BatchSearchResponse, error)
+	// BatchSearchStream answers each distinct name as soon as it is found,
+	// in no particular order
+	BatchSearchStream(Country_BatchSearchStreamServer) error
 }
 
 // UnimplementedCountryServer can be embedded to have forward compatible implementations.
@@ -400,7 +1334,19 @@ type UnimplementedCountryServer struct {
 }
 
 func (*UnimplementedCountryServer) Search(context.Context, *CountryRequest) (*CountryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedCountryServer) SearchAll(*SearchAllRequest, Country_SearchAllServer) error {
+	return status1.Errorf(codes.Unimplemented, "method SearchAll not implemented")
+}
+func (*UnimplementedCountryServer) Lookup(*LookupRequest, Country_LookupServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Lookup not implemented")
+}
+func (*UnimplementedCountryServer) BatchSearch(context.Context, *BatchSearchRequest) (*BatchSearchResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchSearch not implemented")
+}
+func (*UnimplementedCountryServer) BatchSearchStream(Country_BatchSearchStreamServer) error {
+	return status1.Errorf(codes.Unimplemented, "method BatchSearchStream not implemented")
 }
 
 func RegisterCountryServer(s *grpc.Server, srv CountryServer) {
@@ -425,6 +1371,92 @@ func _Country_Search_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Country_SearchAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CountryServer).SearchAll(m, &countrySearchAllServer{stream})
+}
+
+type Country_SearchAllServer interface {
+	Send(*CountryResponse) error
+	grpc.ServerStream
+}
+
+type countrySearchAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *countrySearchAllServer) Send(m *CountryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Country_Lookup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LookupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CountryServer).Lookup(m, &countryLookupServer{stream})
+}
+
+type Country_LookupServer interface {
+	Send(*CountryResponse) error
+	grpc.ServerStream
+}
+
+type countryLookupServer struct {
+	grpc.ServerStream
+}
+
+func (x *countryLookupServer) Send(m *CountryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Country_BatchSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountryServer).BatchSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/countries.Country/BatchSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountryServer).BatchSearch(ctx, req.(*BatchSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Country_BatchSearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CountryServer).BatchSearchStream(&countryBatchSearchStreamServer{stream})
+}
+
+type Country_BatchSearchStreamServer interface {
+	Send(*BatchSearchResult) error
+	Recv() (*CountryRequest, error)
+	grpc.ServerStream
+}
+
+type countryBatchSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *countryBatchSearchStreamServer) Send(m *BatchSearchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *countryBatchSearchStreamServer) Recv() (*CountryRequest, error) {
+	m := new(CountryRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Country_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "countries.Country",
 	HandlerType: (*CountryServer)(nil),
@@ -433,7 +1465,28 @@ var _Country_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Country_Search_Handler,
 		},
+		{
+			MethodName: "BatchSearch",
+			Handler:    _Country_BatchSearch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchAll",
+			Handler:       _Country_SearchAll_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Lookup",
+			Handler:       _Country_Lookup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchSearchStream",
+			Handler:       _Country_BatchSearchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "countries.proto",
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// defaultRetryDelay is suggested to clients when the upstream gives no hint
+const defaultRetryDelay = time.Second
+
+// toStatus turns an error from a CountrySource into a gRPC status error
+// carrying details clients can act on
+func toStatus(err error, name string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var upstreamErr *upstreamError
+	var payloadErr *payloadError
+	var circuitErr *circuitOpenError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, errCountryNotFound):
+		return notFound(name, nil)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return status.Error(codes.DeadlineExceeded, "upstream did not answer in time")
+	case errors.As(err, &circuitErr):
+		return unavailable(circuitErr.Error(), circuitErr.RetryAfter)
+	case errors.As(err, &payloadErr):
+		var schemaErr *schemaError
+		if errors.As(payloadErr, &schemaErr) {
+			return withDetails(codes.Internal, payloadErr.Error(), &errdetails.ErrorInfo{
+				Reason: "UPSTREAM_SCHEMA_MISMATCH",
+				Domain: "restcountries.com",
+				Metadata: map[string]string{
+					"field":   schemaErr.Field,
+					"problem": schemaErr.Problem,
+				},
+			})
+		}
+		return status.Error(codes.Internal, payloadErr.Error())
+	case errors.As(err, &upstreamErr):
+		if upstreamErr.StatusCode < http.StatusInternalServerError &&
+			upstreamErr.StatusCode != http.StatusTooManyRequests {
+			return status.Error(codes.Internal, upstreamErr.Error())
+		}
+		return unavailable(upstreamErr.Error(), upstreamErr.RetryAfter)
+	default:
+		return unavailable(err.Error(), 0)
+	}
+}
+
+// notFound reports that no country matches name, suggesting close names if any
+func notFound(name string, suggestions []string) error {
+	details := []protoiface.MessageV1{&errdetails.ResourceInfo{
+		ResourceType: "country",
+		ResourceName: name,
+		Description:  "no country matches this name",
+	}}
+	message := "country " + name + " not found"
+	if len(suggestions) > 0 {
+		message += ", did you mean " + strings.Join(suggestions, ", ") + "?"
+		details = append(details, &countries.SearchSuggestions{Names: suggestions})
+	}
+	return withDetails(codes.NotFound, message, details...)
+}
+
+// unavailable tells the client to retry after delay, or a default delay if none is known
+func unavailable(message string, delay time.Duration) error {
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+	return withDetails(codes.Unavailable, message, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	})
+}
+
+// invalidArgument reports a bad request field
+func invalidArgument(field, description string) error {
+	return withDetails(codes.InvalidArgument, description, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+}
+
+// withDetails builds a status error, dropping details that fail to marshal
+func withDetails(code codes.Code, message string, details ...protoiface.MessageV1) error {
+	st := status.New(code, message)
+	for _, detail := range details {
+		if withDetail, err := st.WithDetails(detail); err == nil {
+			st = withDetail
+		}
+	}
+	return st.Err()
+}
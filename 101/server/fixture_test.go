@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// record re-records the fixtures against the live upstream:
+//
+//	go test -run TestSearchFixtures -record
+var record = flag.Bool("record", false, "record upstream fixtures against the live API")
+
+const fixtureDir = "testdata/fixtures"
+
+// fixture is an upstream answer saved in testdata/fixtures
+type fixture struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	// Delay holds the answer back, to simulate a slow upstream
+	Delay string `json:"delay,omitempty"`
+	Body  string `json:"body"`
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// fixturePath names the fixture file of an upstream request
+func fixturePath(req *http.Request) string {
+	key := strings.ToLower(req.Method + " " + req.URL.RequestURI())
+	key = strings.Trim(nonAlphanumeric.ReplaceAllString(key, "_"), "_")
+	return filepath.Join(fixtureDir, key+".json")
+}
+
+// replayTransport answers upstream requests from the recorded fixtures
+type replayTransport struct{}
+
+func (replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	data, err := ioutil.ReadFile(fixturePath(req))
+	if err != nil {
+		return nil, fmt.Errorf("no fixture for %s %s: %v", req.Method, req.URL, err)
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	if f.Delay != "" {
+		delay, err := time.ParseDuration(f.Delay)
+		if err != nil {
+			return nil, err
+		}
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Header,
+		Body:          ioutil.NopCloser(strings.NewReader(f.Body)),
+		ContentLength: int64(len(f.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordTransport saves every upstream answer as a fixture
+type recordTransport struct {
+	next http.RoundTripper
+}
+
+func (t recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	for _, name := range []string{"Content-Type", "Retry-After"} {
+		if values := resp.Header.Values(name); len(values) > 0 {
+			header[name] = values
+		}
+	}
+	data, err := json.MarshalIndent(fixture{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: header,
+		Body:   string(body),
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(fixtureDir, 0755); err != nil {
+		return nil, err
+	}
+	return resp, ioutil.WriteFile(fixturePath(req), append(data, '\n'), 0644)
+}
+
+// newFixtureSource talks to the real upstream URL through the fixtures
+func newFixtureSource(timeout time.Duration) *HTTPSource {
+	options := DefaultHTTPOptions
+	options.Timeout = timeout
+	source := NewHTTPSource("https://restcountries.com/v2", options)
+	if *record {
+		source.client.Transport = recordTransport{next: source.client.Transport}
+	} else {
+		source.client.Transport = replayTransport{}
+	}
+	return source
+}
+
+func TestSearchFixtures(t *testing.T) {
+	client := dialTestServer(t, &Server{source: newFixtureSource(200 * time.Millisecond)})
+
+	tests := []struct {
+		desc string
+		name string
+		want []string
+		// first is what Search answers when it isn't want[0]
+		first string
+		code  codes.Code
+		// synthetic fixtures were written by hand and can't be recorded
+		synthetic bool
+	}{
+		{desc: "single match", name: "Brazil", want: []string{"BR"}},
+		// Search prefers the exact name over the upstream order
+		{desc: "multiple matches", name: "guinea", want: []string{"GQ", "GN", "GW", "PG"}, first: "GN"},
+		{desc: "not found", name: "Atlantis", code: codes.NotFound},
+		{desc: "malformed JSON", name: "Brokenland", code: codes.Internal, synthetic: true},
+		{desc: "upstream down", name: "Busyland", code: codes.Unavailable, synthetic: true},
+		{desc: "slow upstream", name: "Slowvakia", code: codes.DeadlineExceeded, synthetic: true},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if *record && test.synthetic {
+				t.Skip("synthetic fixture")
+			}
+
+			response, err := client.Search(context.Background(), &countries.CountryRequest{Name: test.name})
+			if code := status.Code(err); code != test.code {
+				t.Fatalf("Search(%q) code = %v, want %v (%v)", test.name, code, test.code, err)
+			}
+			first := test.first
+			if first == "" && len(test.want) > 0 {
+				first = test.want[0]
+			}
+			if err == nil && response.Alpha2Code != first {
+				t.Errorf("Search(%q) = %s, want %s", test.name, response.Alpha2Code, first)
+			}
+
+			stream, err := client.SearchAll(context.Background(), &countries.SearchAllRequest{Name: test.name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					if code := status.Code(err); code != test.code {
+						t.Errorf("SearchAll(%q) code = %v, want %v", test.name, code, test.code)
+					}
+					break
+				}
+				got = append(got, response.Alpha2Code)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("SearchAll(%q) = %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
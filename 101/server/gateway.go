@@ -0,0 +1,85 @@
+package main
+
+import (
+	_ "embed"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"server/countries"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// openAPISpec is generated from the annotations in countries.proto
+//
+//go:embed openapi/countries.swagger.json
+var openAPISpec []byte
+
+// newGateway returns the REST/JSON facade of the Country service, which
+// proxies every call to the gRPC server listening on grpcAddr
+func newGateway(ctx context.Context, grpcAddr string, dialOpts []grpc.DialOption) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithMetadata(gatewayClientID),
+	)
+	if err := countries.RegisterCountryHandlerFromEndpoint(ctx, gateway, grpcAddr, dialOpts); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gateway)
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPISpec)
+	})
+	return mux, nil
+}
+
+// gatewayClientID passes the identity of the HTTP client on, otherwise every
+// gateway call would share the rate limit of the gateway itself
+func gatewayClientID(ctx context.Context, r *http.Request) metadata.MD {
+	id := r.Header.Get(clientIDKey)
+	if id == "" {
+		id = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			id = host
+		}
+	}
+	return metadata.Pairs(clientIDKey, id)
+}
+
+// gatewayErrorHandler maps gRPC status codes to HTTP ones like the default
+// handler does, and turns RetryInfo into a Retry-After header
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	for _, detail := range status.Convert(err).Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := math.Ceil(retry.RetryDelay.AsDuration().Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// dialAddress turns a listen address into one the gateway can dial
+func dialAddress(listen string) string {
+	host, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return listen
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestGateway(t *testing.T) {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	countries.RegisterCountryServer(grpcServer, newTestServer(t))
+	go grpcServer.Serve(listen)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gateway, err := newGateway(ctx, listen.Addr().String(),
+		[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+
+	tests := []struct {
+		method, path, body string
+		code               int
+		contains           string
+	}{
+		{"GET", "/v1/countries/Brazil", "", http.StatusOK, `"alpha2Code":"BR"`},
+		{"GET", "/v1/countries/Atlantis", "", http.StatusNotFound, `"code":5`},
+		{"GET", "/v1/countries/guinea/matches?maxResults=2", "", http.StatusOK, `"alpha2Code":"GQ"`},
+		{"GET", "/v1/lookup?currency=JPY", "", http.StatusOK, `"alpha2Code":"JP"`},
+		{"GET", "/v1/lookup", "", http.StatusBadRequest, `"code":3`},
+		{"POST", "/v1/countries:batchSearch", `{"names": ["India", "Atlantis"]}`, http.StatusOK, `"alpha2Code":"IN"`},
+		{"GET", "/openapi.json", "", http.StatusOK, `"swagger": "2.0"`},
+	}
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, server.URL+test.path, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// streaming methods answer with one JSON object per line
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != test.code || !strings.Contains(string(body), test.contains) {
+			t.Errorf("%s %s = %d %s, want %d with %s", test.method, test.path, resp.StatusCode, body, test.code, test.contains)
+		}
+	}
+}
+
+func TestDialAddress(t *testing.T) {
+	for listen, want := range map[string]string{
+		"0.0.0.0:3000":   "localhost:3000",
+		":3000":          "localhost:3000",
+		"[::]:3000":      "localhost:3000",
+		"10.0.0.12:3000": "10.0.0.12:3000",
+	} {
+		if got := dialAddress(listen); got != want {
+			t.Errorf("dialAddress(%q) = %q, want %q", listen, got, want)
+		}
+	}
+}
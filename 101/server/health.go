@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// countryService is the name the Country service reports its health under
+const countryService = "countries.Country"
+
+// Pinger is implemented by sources that can tell whether their upstream is reachable
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// watchUpstream checks the upstream every interval until ctx is done and
+// reports the Country service as serving only while the upstream is reachable
+func watchUpstream(ctx context.Context, healthServer *health.Server, source CountrySource, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		checkUpstream(ctx, healthServer, source, interval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkUpstream updates the health status once, giving the upstream timeout to answer
+func checkUpstream(ctx context.Context, healthServer *health.Server, source CountrySource, timeout time.Duration) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if pinger, ok := source.(Pinger); ok {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := pinger.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				// shutting down, the server reports NOT_SERVING by itself
+				return
+			}
+			log.Printf("upstream is unreachable %v", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(countryService, status)
+}
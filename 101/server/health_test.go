@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckUpstream(t *testing.T) {
+	up := true
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer upstream.Close()
+	source := NewHTTPSource(upstream.URL, DefaultHTTPOptions)
+	healthServer := health.NewServer()
+
+	servingStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+		response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: countryService})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response.Status
+	}
+
+	checkUpstream(context.Background(), healthServer, source, time.Second)
+	if got := servingStatus(); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected SERVING while the upstream answers, got %v", got)
+	}
+	up = false
+	checkUpstream(context.Background(), healthServer, source, time.Second)
+	if got := servingStatus(); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected NOT_SERVING while the upstream fails, got %v", got)
+	}
+
+	// sources without an upstream are always reachable
+	checkUpstream(context.Background(), healthServer, NewMemorySource(), time.Second)
+	if got := servingStatus(); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected SERVING for an in-memory source, got %v", got)
+	}
+
+	// an aggregation is reachable while any provider is, files always are
+	providers := []Provider{
+		{Name: "restcountries", Source: NewResilientSource(NewHTTPSource(upstream.URL, DefaultHTTPOptions), DefaultRetryOptions, DefaultBreakerOptions)},
+		{Name: "mirror", Source: NewResilientSource(NewHTTPSource(upstream.URL, DefaultHTTPOptions), DefaultRetryOptions, DefaultBreakerOptions)},
+	}
+	checkUpstream(context.Background(), healthServer, NewAggregateSource(0, providers...), time.Second)
+	if got := servingStatus(); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected NOT_SERVING while every provider fails, got %v", got)
+	}
+	providers = append(providers, Provider{Name: "file", Source: NewMemorySource()})
+	checkUpstream(context.Background(), healthServer, NewAggregateSource(0, providers...), time.Second)
+	if got := servingStatus(); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected SERVING with a file provider, got %v", got)
+	}
+}
@@ -1,47 +1,296 @@
 package main
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"errors"
+	"expvar"
+	"flag"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"server/countries"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
 )
 
 func main() {
-	grpcServer := grpc.NewServer()
-	var server Server
+	cfg, err := LoadConfig(os.Args[1:])
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if cfg.PrintConfig {
+		out, err := yaml.Marshal(cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(out)
+		return
+	}
+
+	ctx, stopWatching := context.WithCancel(context.Background())
+	source, err := NewSource(ctx, cfg)
+	if err != nil {
+		log.Fatalf("could not create country source %v", err)
+	}
+	if snapshot, ok := source.(*SnapshotSource); ok {
+		expvar.Publish("country_snapshot", expvar.Func(func() interface{} { return snapshot.Stats() }))
+		if cfg.Snapshot.Refresh > 0 {
+			go snapshot.Run(ctx, cfg.Snapshot.Refresh)
+		}
+	}
+	upstream := source
+	if aggregate, ok := source.(*AggregateSource); ok {
+		// every provider has retries and a circuit breaker of its own
+		expvar.Publish("country_upstream", expvar.Func(func() interface{} { return aggregate.Stats() }))
+	} else {
+		resilient := NewResilientSource(source, cfg.Retry, cfg.Breaker)
+		expvar.Publish("country_upstream", expvar.Func(func() interface{} { return resilient.Stats() }))
+		source = resilient
+	}
+	if cfg.Cache.Size > 0 {
+		cache := NewCachedSource(source, cfg.Cache.TTL, cfg.Cache.NegativeTTL, cfg.Cache.Size)
+		expvar.Publish("country_cache", expvar.Func(func() interface{} { return cache.Stats() }))
+		source = cache
+	}
+	if cfg.Metrics != "" {
+		go func() {
+			log.Fatal(http.ListenAndServe(cfg.Metrics, nil))
+		}()
+	}
+
+	var opts []grpc.ServerOption
+	if cfg.RateLimit.Rate > 0 || len(cfg.RateLimit.Methods) > 0 {
+		limiter := NewRateLimiter(cfg.RateLimit.RateLimit, cfg.RateLimit.Methods)
+		expvar.Publish("country_rate_limit", expvar.Func(func() interface{} { return limiter.Stats() }))
+		opts = append(opts,
+			grpc.UnaryInterceptor(limiter.UnaryInterceptor),
+			grpc.StreamInterceptor(limiter.StreamInterceptor),
+		)
+	}
+	if cfg.TLS.CertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			log.Fatalf("failed loading certificates: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	server := &Server{source: source, batchConcurrency: cfg.BatchConcurrency}
+	if suggester, ok := upstream.(Suggester); ok {
+		server.suggester = suggester
+	}
 	countries.RegisterCountryServer(grpcServer, server)
-	listen, err := net.Listen("tcp", "0.0.0.0:3000")
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go watchUpstream(ctx, healthServer, upstream, cfg.HealthInterval)
+
+	listen, err := net.Listen("tcp", cfg.Listen)
 	if err != nil {
-		log.Fatalf("could not listen to 0.0.0.0:3000 %v", err)
+		log.Fatalf("could not listen to %s %v", cfg.Listen, err)
+	}
+	log.Printf("Server starting on %s...", cfg.Listen)
+	go func() {
+		if err := grpcServer.Serve(listen); err != nil {
+			log.Fatalf("failed to serve %v", err)
+		}
+	}()
+
+	var gatewayServer *http.Server
+	if cfg.Gateway != "" {
+		dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+		if cfg.TLS.CertFile != "" {
+			creds, err := credentials.NewClientTLSFromFile(cfg.TLS.CertFile, "")
+			if err != nil {
+				log.Fatalf("failed loading certificates: %v", err)
+			}
+			dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+		}
+		gateway, err := newGateway(ctx, dialAddress(cfg.Listen), dialOpts)
+		if err != nil {
+			log.Fatalf("could not create gateway %v", err)
+		}
+		gatewayServer = &http.Server{Addr: cfg.Gateway, Handler: gateway}
+		log.Printf("Gateway starting on %s...", cfg.Gateway)
+		go func() {
+			if err := gatewayServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Fatalf("failed to serve gateway %v", err)
+			}
+		}()
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
+	<-stop
+	log.Println("Server stopping...")
+	healthServer.Shutdown()
+	if gatewayServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		gatewayServer.Shutdown(shutdownCtx)
+		cancel()
+	}
+	gracefulStop(grpcServer, cfg.ShutdownTimeout)
+	stopWatching()
+}
+
+// gracefulStop lets in-flight calls finish for at most timeout before closing them
+func gracefulStop(grpcServer *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(done)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		log.Println("drain timeout reached, closing remaining calls")
+		grpcServer.Stop()
 	}
-	log.Println("Server starting...")
-	log.Fatal(grpcServer.Serve(listen))
 }
 
 // Server is implementation proto interface
-type Server struct{}
+type Server struct {
+	source           CountrySource
+	batchConcurrency int
+	// suggester, when set, proposes close names for searches matching nothing
+	suggester Suggester
+}
 
 // Search function responsible to get the Country information
-func (Server) Search(ctx context.Context, request *countries.CountryRequest) (*countries.CountryResponse, error) {
-	resp, err := http.Get("https://restcountries.com/v2/name/ " + request.Name)
+func (s *Server) Search(ctx context.Context, request *countries.CountryRequest) (*countries.CountryResponse, error) {
+	country, err := s.search(ctx, request.Name)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	jsonData, err := ioutil.ReadAll(resp.Body)
+	if len(country.Provenance) > 0 {
+		grpc.SetHeader(ctx, metadata.Pairs(provenanceKey, provenanceHeader(country)))
+	}
+	return country, nil
+}
+
+// search finds the country named name, it leaves the response headers
+// to Search so that batches can search names concurrently
+func (s *Server) search(ctx context.Context, name string) (*countries.CountryResponse, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, invalidArgument("name", "country name must not be empty")
+	}
+	name = cleanName(name)
+	data, err := s.source.Search(ctx, name)
 	if err != nil {
-		return nil, err
+		return nil, s.searchStatus(ctx, err, name)
 	}
-	var data []countries.CountryResponse
-	if err := json.Unmarshal(jsonData, &data); err != nil {
-		return nil, err
+	// prefer the country named exactly as requested over partial matches
+	for _, c := range data {
+		if isExactMatch(c, name) {
+			return c, nil
+		}
+	}
+	return data[0], nil
+}
+
+// SearchAll streams every country matching the requested name
+func (s *Server) SearchAll(request *countries.SearchAllRequest, stream countries.Country_SearchAllServer) error {
+	if strings.TrimSpace(request.Name) == "" {
+		return invalidArgument("name", "country name must not be empty")
+	}
+	if request.MaxResults < 0 {
+		return invalidArgument("maxResults", "maxResults must not be negative")
+	}
+	name := cleanName(request.Name)
+	data, err := s.source.Search(stream.Context(), name)
+	if err != nil {
+		return s.searchStatus(stream.Context(), err, name)
+	}
+	sent := 0
+	for _, country := range data {
+		if request.ExactMatch && !isExactMatch(country, name) {
+			continue
+		}
+		if err := stream.Send(country); err != nil {
+			return err
+		}
+		sent++
+		if sent == int(request.MaxResults) {
+			break
+		}
+	}
+	if sent == 0 {
+		return s.searchStatus(stream.Context(), errCountryNotFound, name)
+	}
+	return nil
+}
+
+// searchStatus is toStatus for searches, adding suggestions when nothing matched name
+func (s *Server) searchStatus(ctx context.Context, err error, name string) error {
+	if !errors.Is(err, errCountryNotFound) || s.suggester == nil {
+		return toStatus(err, name)
+	}
+	return notFound(name, s.suggester.Suggest(ctx, name, maxSuggestions))
+}
+
+// isExactMatch reports whether name is the full name or native name of
+// country, ignoring case and accents
+func isExactMatch(country *countries.CountryResponse, name string) bool {
+	name = foldName(name)
+	return foldName(country.Name) == name || foldName(country.NativeName) == name
+}
+
+// Lookup streams every country matching the field selected in the request
+func (s *Server) Lookup(request *countries.LookupRequest, stream countries.Country_LookupServer) error {
+	by, value, err := lookupQuery(request)
+	if err != nil {
+		return err
+	}
+	data, err := s.source.Lookup(stream.Context(), by, value)
+	if err != nil {
+		return toStatus(err, value)
+	}
+	for _, country := range data {
+		if err := stream.Send(country); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupQuery validates request and tells which field to look countries up by
+func lookupQuery(request *countries.LookupRequest) (by, value string, err error) {
+	switch selector := request.By.(type) {
+	case *countries.LookupRequest_Code:
+		by, value = byCode, selector.Code
+		if n := len(strings.TrimSpace(value)); n != 2 && n != 3 {
+			return "", "", invalidArgument("code", "code must be an alpha-2 or alpha-3 country code")
+		}
+	case *countries.LookupRequest_Currency:
+		by, value = byCurrency, selector.Currency
+	case *countries.LookupRequest_Capital:
+		by, value = byCapital, selector.Capital
+	case *countries.LookupRequest_Region:
+		by, value = byRegion, selector.Region
+	case *countries.LookupRequest_Subregion:
+		by, value = bySubregion, selector.Subregion
+	default:
+		return "", "", invalidArgument("by", "one of code, currency, capital, region or subregion is required")
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", "", invalidArgument(by, by+" must not be empty")
 	}
-	return &data[0], nil
+	return by, value, nil
 }
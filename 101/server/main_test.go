@@ -1,22 +1,285 @@
 package main
 
 import (
+	"fmt"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
 	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"server/countries"
 	"testing"
+	"time"
 )
 
+func newTestServer(t *testing.T) *Server {
+	source, err := LoadFileSource("testdata/countries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Server{source: source, suggester: source}
+}
+
+// dialTestServer serves server over an in-memory listener and returns a client for it
+func dialTestServer(t *testing.T, server *Server) countries.CountryClient {
+	listen := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	countries.RegisterCountryServer(grpcServer, server)
+	go grpcServer.Serve(listen)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listen.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return countries.NewCountryClient(conn)
+}
+
 func TestCountry(t *testing.T) {
 	ctx := context.Background()
 	request := countries.CountryRequest{Name: "Brazil"}
-	server := Server{}
+	server := newTestServer(t)
 	response, err := server.Search(ctx, &request)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 	if response.Alpha2Code != "BR" {
 		t.Error("Different Country returned")
 	}
+	if response.Alpha3Code != "BRA" || len(response.Languages) != 1 || response.Languages[0].Iso639_1 != "pt" ||
+		len(response.Borders) != 10 || len(response.Timezones) != 4 || response.Area != 8515767 ||
+		len(response.Latlng) != 2 || response.CallingCodes[0] != "55" || response.Flag != "https://flagcdn.com/br.svg" {
+		t.Errorf("upstream fields not populated: %v", response)
+	}
 	log.Println(response)
 }
+
+func TestMemorySourceNotFound(t *testing.T) {
+	source := NewMemorySource(&countries.CountryResponse{Name: "Brazil", NativeName: "Brasil"})
+	if _, err := source.Search(context.Background(), "brasil"); err != nil {
+		t.Errorf("native name should match: %v", err)
+	}
+	if _, err := source.Search(context.Background(), "Atlantis"); err != errCountryNotFound {
+		t.Errorf("expected errCountryNotFound, got %v", err)
+	}
+}
+
+func TestSearchStatus(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/name/Brazil":
+			fmt.Fprint(w, `[{"name": "Brazil", "alpha2Code": "BR"}]`)
+		case "/name/Empty":
+			fmt.Fprint(w, `[]`)
+		case "/name/Broken":
+			fmt.Fprint(w, `{"name": `)
+		case "/name/Busy":
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status": 404, "message": "Not Found"}`)
+		}
+	}))
+	defer upstream.Close()
+	server := &Server{source: NewHTTPSource(upstream.URL, DefaultHTTPOptions)}
+
+	tests := []struct {
+		name string
+		code codes.Code
+	}{
+		{"Brazil", codes.OK},
+		{"Atlantis", codes.NotFound},
+		{"Empty", codes.NotFound},
+		{"Broken", codes.Internal},
+		{"Busy", codes.Unavailable},
+		{" ", codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		_, err := server.Search(context.Background(), &countries.CountryRequest{Name: test.name})
+		if code := status.Code(err); code != test.code {
+			t.Errorf("Search(%q) code = %v, want %v (%v)", test.name, code, test.code, err)
+		}
+	}
+
+	_, err := server.Search(context.Background(), &countries.CountryRequest{Name: "Busy"})
+	details := status.Convert(err).Details()
+	if len(details) != 1 {
+		t.Fatalf("expected RetryInfo detail, got %v", details)
+	}
+	if retry, ok := details[0].(*errdetails.RetryInfo); !ok || retry.RetryDelay.AsDuration() != 30*time.Second {
+		t.Errorf("expected 30s RetryInfo, got %v", details[0])
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	client := dialTestServer(t, newTestServer(t))
+
+	tests := []struct {
+		request *countries.SearchAllRequest
+		want    []string
+		code    codes.Code
+	}{
+		{
+			request: &countries.SearchAllRequest{Name: "guinea"},
+			want:    []string{"GN", "GQ", "GW", "PG"},
+		},
+		{
+			request: &countries.SearchAllRequest{Name: "guinea", MaxResults: 2},
+			want:    []string{"GN", "GQ"},
+		},
+		{
+			request: &countries.SearchAllRequest{Name: "Guinea", ExactMatch: true},
+			want:    []string{"GN"},
+		},
+		{
+			request: &countries.SearchAllRequest{Name: "guine", ExactMatch: true},
+			code:    codes.NotFound,
+		},
+		{
+			request: &countries.SearchAllRequest{Name: "guinea", MaxResults: -1},
+			code:    codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		stream, err := client.SearchAll(context.Background(), test.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if code := status.Code(err); code != test.code {
+					t.Errorf("SearchAll(%v) code = %v, want %v", test.request, code, test.code)
+				}
+				break
+			}
+			got = append(got, response.Alpha2Code)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.want) {
+			t.Errorf("SearchAll(%v) = %v, want %v", test.request, got, test.want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	client := dialTestServer(t, newTestServer(t))
+
+	tests := []struct {
+		request *countries.LookupRequest
+		want    []string
+		code    codes.Code
+	}{
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Code{Code: "br"}},
+			want:    []string{"BR"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Code{Code: "DEU"}},
+			want:    []string{"DE"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Currency{Currency: "eur"}},
+			want:    []string{"DE", "FR"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Capital{Capital: "tokyo"}},
+			want:    []string{"JP"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Region{Region: "Oceania"}},
+			want:    []string{"PG"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Subregion{Subregion: "western africa"}},
+			want:    []string{"GN", "GW"},
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Currency{Currency: "XYZ"}},
+			code:    codes.NotFound,
+		},
+		{
+			request: &countries.LookupRequest{By: &countries.LookupRequest_Code{Code: "BRAZ"}},
+			code:    codes.InvalidArgument,
+		},
+		{
+			request: &countries.LookupRequest{},
+			code:    codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		stream, err := client.Lookup(context.Background(), test.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if code := status.Code(err); code != test.code {
+					t.Errorf("Lookup(%v) code = %v, want %v", test.request, code, test.code)
+				}
+				break
+			}
+			got = append(got, response.Alpha2Code)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.want) {
+			t.Errorf("Lookup(%v) = %v, want %v", test.request, got, test.want)
+		}
+	}
+}
+
+func TestSearchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer upstream.Close()
+	defer close(release)
+
+	options := DefaultHTTPOptions
+	options.Timeout = 50 * time.Millisecond
+	server := &Server{source: NewHTTPSource(upstream.URL, options)}
+	_, err := server.Search(context.Background(), &countries.CountryRequest{Name: "Brazil"})
+	if code := status.Code(err); code != codes.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded from the default timeout, got %v", err)
+	}
+
+	options.Timeout = time.Minute
+	server = &Server{source: NewHTTPSource(upstream.URL, options)}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = server.Search(ctx, &countries.CountryRequest{Name: "Brazil"})
+	if code := status.Code(err); code != codes.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded from the caller deadline, got %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = server.Search(ctx, &countries.CountryRequest{Name: "Brazil"})
+	if code := status.Code(err); code != codes.Canceled {
+		t.Errorf("expected Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/context"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxSuggestions is how many close names a not found search suggests
+const maxSuggestions = 5
+
+// Suggester is implemented by sources that know every country name and
+// can tell which ones are close to a name nothing matched
+type Suggester interface {
+	Suggest(ctx context.Context, name string, max int) []string
+}
+
+// cleanName trims name and collapses the whitespace inside it
+func cleanName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// foldName normalizes a name for comparison, so "  Côte  D'IVOIRE"
+// and "cote d'ivoire" are equal
+func foldName(name string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), cleanName(name))
+	if err != nil {
+		folded = cleanName(name)
+	}
+	return strings.ToLower(folded)
+}
+
+// levenshtein counts the rune insertions, deletions and substitutions
+// turning a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		diagonal := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			next := min3(row[j]+1, row[j-1]+1, diagonal+cost)
+			diagonal = row[j]
+			row[j] = next
+		}
+	}
+	return row[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// suggest ranks the candidates close enough to name, closest first.
+// candidates maps a folded name to the country name to suggest for it.
+func suggest(name string, candidates map[string]string, max int) []string {
+	query := foldName(name)
+	// allow a typo every three runes, so short names need to be nearly right
+	limit := len([]rune(query)) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	best := make(map[string]int)
+	for folded, country := range candidates {
+		distance := levenshtein(query, folded)
+		if previous, ok := best[country]; distance <= limit && (!ok || distance < previous) {
+			best[country] = distance
+		}
+	}
+	ranked := make([]suggestion, 0, len(best))
+	for country, distance := range best {
+		ranked = append(ranked, suggestion{country, distance})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].distance != ranked[j].distance {
+			return ranked[i].distance < ranked[j].distance
+		}
+		return ranked[i].name < ranked[j].name
+	})
+	var names []string
+	for i := 0; i < len(ranked) && i < max; i++ {
+		names = append(names, ranked[i].name)
+	}
+	return names
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFoldName(t *testing.T) {
+	tests := map[string]string{
+		"  Côte  D'IVOIRE ": "cote d'ivoire",
+		"São Tomé":          "sao tome",
+		"Österreich":        "osterreich",
+		"日本":                "日本",
+	}
+	for name, want := range tests {
+		if got := foldName(name); got != want {
+			t.Errorf("foldName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"brazil", "brazil", 0},
+		{"brazl", "brazil", 1},
+		{"germnay", "germany", 2},
+		{"", "peru", 4},
+		{"日本", "日本国", 1},
+	}
+	for _, test := range tests {
+		if got := levenshtein(test.a, test.b); got != test.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	source, err := LoadFileSource("testdata/countries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"Brazl", []string{"Brazil"}},
+		{"Brasill", []string{"Brazil"}},
+		{"Deutchland", []string{"Germany"}},
+		{"Gunea", []string{"Guinea"}},
+		{"Atlantis", nil},
+	}
+	for _, test := range tests {
+		if got := source.Suggest(ctx, test.name, maxSuggestions); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Suggest(%q) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestSearchLocalized(t *testing.T) {
+	client := dialTestServer(t, newTestServer(t))
+	ctx := context.Background()
+
+	for name, want := range map[string]string{
+		"Deutschland":      "DE",
+		"brasil":           "BR",
+		"  united  states": "US",
+		"GUINÉE":           "GN",
+		"SCHWEIZ":          "CH",
+	} {
+		response, err := client.Search(ctx, &countries.CountryRequest{Name: name})
+		if err != nil {
+			t.Errorf("Search(%q) failed %v", name, err)
+			continue
+		}
+		if response.Alpha2Code != want {
+			t.Errorf("Search(%q) = %s, want %s", name, response.Alpha2Code, want)
+		}
+	}
+
+	_, err := client.Search(ctx, &countries.CountryRequest{Name: "Brazl"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+	var suggestions []string
+	for _, detail := range status.Convert(err).Details() {
+		if s, ok := detail.(*countries.SearchSuggestions); ok {
+			suggestions = s.Names
+		}
+	}
+	if !reflect.DeepEqual(suggestions, []string{"Brazil"}) {
+		t.Errorf("expected Brazil to be suggested, got %v", suggestions)
+	}
+}
+
+func TestSuggestHTTP(t *testing.T) {
+	fetches := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/all" && r.URL.Query().Get("fields") == "name,nativeName":
+			fetches++
+			fmt.Fprint(w, `[{"name": "Brazil", "nativeName": "Brasil"}, {"name": "Germany", "nativeName": "Deutschland"}]`)
+		case r.URL.Path == "/all" && r.URL.Query().Get("fields") == "name":
+			fetches++
+			fmt.Fprint(w, `[{"name": {"common": "Brazil", "nativeName": {"por": {"common": "Brasil"}}}}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer upstream.Close()
+	source := NewHTTPSource(upstream.URL, DefaultHTTPOptions)
+	client := dialTestServer(t, &Server{source: source, suggester: source})
+
+	for _, name := range []string{"Brazl", "Deutchland"} {
+		_, err := client.Search(context.Background(), &countries.CountryRequest{Name: name})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+		var suggestions []string
+		for _, detail := range status.Convert(err).Details() {
+			if s, ok := detail.(*countries.SearchSuggestions); ok {
+				suggestions = s.Names
+			}
+		}
+		if len(suggestions) != 1 {
+			t.Errorf("expected a suggestion for %q, got %v", name, suggestions)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("expected the names to be fetched once, got %d", fetches)
+	}
+
+	// providers of an aggregation suggest names through their circuit breaker
+	aggregate := NewAggregateSource(0, Provider{
+		Name:   "restcountries",
+		Source: NewResilientSource(NewHTTPSource(upstream.URL, DefaultHTTPOptions), DefaultRetryOptions, DefaultBreakerOptions),
+	})
+	if got := aggregate.Suggest(context.Background(), "Germani", maxSuggestions); !reflect.DeepEqual(got, []string{"Germany"}) {
+		t.Errorf("Suggest(Germani) through an aggregation = %v", got)
+	}
+
+	options := DefaultHTTPOptions
+	options.API = "v3"
+	v3 := NewHTTPSource(upstream.URL, options)
+	if got := v3.Suggest(context.Background(), "Brasill", maxSuggestions); !reflect.DeepEqual(got, []string{"Brazil"}) {
+		t.Errorf("Suggest(Brasill) = %v", got)
+	}
+	options.NamesRefresh = 0
+	if got := NewHTTPSource(upstream.URL, options).Suggest(context.Background(), "Brazl", maxSuggestions); got != nil {
+		t.Errorf("expected suggestions to be disabled, got %v", got)
+	}
+}
+
+func TestSuggestHTTPSlowRefresh(t *testing.T) {
+	entered, release := make(chan struct{}), make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		fmt.Fprint(w, `[{"name": "Brazil", "nativeName": "Brasil"}]`)
+	}))
+	defer upstream.Close()
+	source := NewHTTPSource(upstream.URL, DefaultHTTPOptions)
+
+	refreshed := make(chan []string)
+	go func() {
+		refreshed <- source.Suggest(context.Background(), "Brazl", maxSuggestions)
+	}()
+	<-entered
+
+	// other callers don't wait for the names being fetched
+	done := make(chan []string)
+	go func() {
+		done <- source.Suggest(context.Background(), "Brazl", maxSuggestions)
+	}()
+	select {
+	case got := <-done:
+		if got != nil {
+			t.Errorf("expected no suggestions before the names are known, got %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Suggest waited for another caller's refresh")
+	}
+	close(release)
+	if got := <-refreshed; !reflect.DeepEqual(got, []string{"Brazil"}) {
+		t.Errorf("Suggest(Brazl) = %v", got)
+	}
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// clientIDKey is the metadata key callers identify themselves with,
+// callers without one are told apart by their address
+const clientIDKey = "x-client-id"
+
+// RateLimit is a token bucket refilled with Rate tokens per second and
+// holding at most Burst of them, a zero Rate disables it
+type RateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// MethodLimits overrides the rate limit of some methods, keyed by the
+// method name like "BatchSearch". As a flag it reads "BatchSearch=1:5,Search=20:40".
+type MethodLimits map[string]RateLimit
+
+func (m MethodLimits) String() string {
+	var limits []string
+	for method, limit := range m {
+		limits = append(limits, fmt.Sprintf("%s=%s:%d", method, strconv.FormatFloat(limit.Rate, 'g', -1, 64), limit.Burst))
+	}
+	sort.Strings(limits)
+	return strings.Join(limits, ",")
+}
+
+// Set parses the flag form of the limits, replacing the current ones
+func (m *MethodLimits) Set(value string) error {
+	limits := make(MethodLimits)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		parts := strings.FieldsFunc(item, func(r rune) bool { return r == '=' || r == ':' })
+		if len(parts) != 3 || strings.Count(item, "=") != 1 {
+			return fmt.Errorf("%q is not method=rate:burst", item)
+		}
+		method, rate, burst := parts[0], parts[1], parts[2]
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rate in %q", item)
+		}
+		b, err := strconv.Atoi(burst)
+		if err != nil {
+			return fmt.Errorf("invalid burst in %q", item)
+		}
+		limits[method] = RateLimit{Rate: r, Burst: b}
+	}
+	*m = limits
+	return nil
+}
+
+// RateLimitStats are the counters exposed by RateLimiter
+type RateLimitStats struct {
+	Allowed  uint64 `json:"allowed"`
+	Rejected uint64 `json:"rejected"`
+	Buckets  int    `json:"buckets"`
+}
+
+// RateLimiter gives every client a token bucket per method, so one noisy
+// client can't use up the upstream quota of the others
+type RateLimiter struct {
+	limit   RateLimit
+	methods MethodLimits
+	now     func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	stats     RateLimitStats
+}
+
+type bucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter applies limit to every method except the ones in methods
+func NewRateLimiter(limit RateLimit, methods MethodLimits) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		methods: methods,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// UnaryInterceptor rejects unary calls of clients out of tokens. A call
+// costs a token, except BatchSearch which costs one per distinct name.
+func (l *RateLimiter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.allow(ctx, info.FullMethod, callCost(req)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streams of clients out of tokens. A stream
+// costs a single token, except the ones the client sends messages on,
+// which cost a token per message: streaming names to BatchSearchStream
+// costs as much as searching them one by one.
+func (l *RateLimiter) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, &limitedStream{ServerStream: stream, limiter: l, method: info.FullMethod})
+	}
+	if err := l.allow(stream.Context(), info.FullMethod, 1); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// limitedStream takes a token for every message received
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *RateLimiter
+	method  string
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.limiter.allow(s.Context(), s.method, 1)
+}
+
+// callCost returns the tokens a unary request costs, one per upstream
+// search it can cause
+func callCost(req interface{}) int {
+	batch, ok := req.(*countries.BatchSearchRequest)
+	if !ok || len(batch.Names) > maxBatchSize {
+		// oversized batches are rejected without searching anything
+		return 1
+	}
+	seen := make(map[string]bool)
+	for _, name := range batch.Names {
+		seen[cacheKey(name)] = true
+	}
+	if len(seen) == 0 {
+		return 1
+	}
+	return len(seen)
+}
+
+// allow takes cost tokens from the bucket of the calling client. A call
+// costing more than the bucket holds goes through as soon as a token is
+// left, and the bucket stays in debt until it refilled the difference.
+func (l *RateLimiter) allow(ctx context.Context, fullMethod string, cost int) error {
+	limit := l.limitOf(fullMethod)
+	if limit.Rate <= 0 {
+		return nil
+	}
+	client := clientID(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweep(now)
+	key := fullMethod + " " + client
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens -= float64(cost)
+		l.stats.Allowed++
+		return nil
+	}
+	l.stats.Rejected++
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return withDetails(codes.ResourceExhausted, "rate limit exceeded, retry in "+wait.Round(time.Millisecond).String(),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)},
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "client:" + client,
+			Description: fmt.Sprintf("%s allows %g calls per second, bursts of %d", path.Base(fullMethod), limit.Rate, limit.Burst),
+		}}},
+	)
+}
+
+// limitOf returns the limit of a method given as "/countries.Country/Search"
+func (l *RateLimiter) limitOf(fullMethod string) RateLimit {
+	if limit, ok := l.methods[path.Base(fullMethod)]; ok {
+		return limit
+	}
+	if limit, ok := l.methods[fullMethod]; ok {
+		return limit
+	}
+	return l.limit
+}
+
+// refill adds the tokens earned since the bucket was last used
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.Rate
+	if max := float64(b.limit.Burst); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+}
+
+// sweep forgets, at most once a minute, the buckets that filled up again,
+// they are as good as new ones
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Stats returns a snapshot of the rate limit counters
+func (l *RateLimiter) Stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	stats := l.stats
+	stats.Buckets = len(l.buckets)
+	return stats
+}
+
+// clientID identifies the caller by its x-client-id metadata, or its IP address
+func clientID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(clientIDKey); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
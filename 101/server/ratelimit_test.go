@@ -0,0 +1,182 @@
+package main
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := NewRateLimiter(RateLimit{Rate: 1, Burst: 2}, MethodLimits{"SearchAll": {Rate: 0.5, Burst: 1}})
+	limiter.now = func() time.Time { return now }
+
+	listen := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(limiter.UnaryInterceptor),
+		grpc.StreamInterceptor(limiter.StreamInterceptor),
+	)
+	countries.RegisterCountryServer(grpcServer, newTestServer(t))
+	go grpcServer.Serve(listen)
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listen.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := countries.NewCountryClient(conn)
+
+	search := func(clientID string) error {
+		ctx := context.Background()
+		if clientID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, clientIDKey, clientID)
+		}
+		_, err := client.Search(ctx, &countries.CountryRequest{Name: "Brazil"})
+		return err
+	}
+	searchAll := func() error {
+		stream, err := client.SearchAll(context.Background(), &countries.SearchAllRequest{Name: "guinea"})
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+
+	// the burst passes, the next call is limited
+	for i := 0; i < 2; i++ {
+		if err := search("noisy"); err != nil {
+			t.Fatalf("call %d within the burst failed %v", i, err)
+		}
+	}
+	err = search("noisy")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	var retry *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() != time.Second {
+		t.Errorf("expected a 1s RetryInfo, got %v", status.Convert(err).Details())
+	}
+
+	// other clients have buckets of their own, by id or by address
+	if err := search("quiet"); err != nil {
+		t.Errorf("another client was limited %v", err)
+	}
+	if err := search(""); err != nil {
+		t.Errorf("a client without id was limited %v", err)
+	}
+
+	// SearchAll has a limit of its own, enforced on streams too
+	if err := searchAll(); err != nil {
+		t.Fatal(err)
+	}
+	if err := searchAll(); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected the SearchAll limit to apply, got %v", err)
+	}
+
+	// streams the client sends on cost a token per message
+	batchStream := func(clientID string, names ...string) error {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), clientIDKey, clientID)
+		stream, err := client.BatchSearchStream(ctx)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err := stream.Send(&countries.CountryRequest{Name: name}); err != nil {
+				break
+			}
+		}
+		stream.CloseSend()
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	if err := batchStream("batch", "Brazil", "Japan"); err != nil {
+		t.Errorf("a stream of 2 names within the burst failed %v", err)
+	}
+	if err := batchStream("bigger batch", "Brazil", "Japan", "India"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected a stream of 3 names to exhaust a burst of 2, got %v", err)
+	}
+
+	// a batch costs a token per distinct name, leaving the bucket in debt
+	batchCtx := metadata.AppendToOutgoingContext(context.Background(), clientIDKey, "batcher")
+	batch := &countries.BatchSearchRequest{Names: []string{"Brazil", "Japan", "brazil", "India"}}
+	if _, err := client.BatchSearch(batchCtx, batch); err != nil {
+		t.Errorf("a batch with a token left failed %v", err)
+	}
+	_, err = client.BatchSearch(batchCtx, &countries.BatchSearchRequest{Names: []string{"Brazil"}})
+	retry = nil
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if status.Code(err) != codes.ResourceExhausted || retry == nil || retry.RetryDelay.AsDuration() != 2*time.Second {
+		t.Errorf("expected 3 names to leave a debt of 2s, got %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if err := search("noisy"); err != nil {
+		t.Errorf("expected a token after a second, got %v", err)
+	}
+	if err := search("noisy"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected a single token after a second, got %v", err)
+	}
+
+	// full buckets are forgotten
+	now = now.Add(time.Hour)
+	search("noisy")
+	if stats := limiter.Stats(); stats.Buckets != 1 || stats.Rejected != 5 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestMethodLimitsFlag(t *testing.T) {
+	var limits MethodLimits
+	if err := limits.Set("Search=20:40, BatchSearch=0.5:5"); err != nil {
+		t.Fatal(err)
+	}
+	if limits["BatchSearch"] != (RateLimit{Rate: 0.5, Burst: 5}) || limits["Search"] != (RateLimit{Rate: 20, Burst: 40}) {
+		t.Errorf("unexpected limits %v", limits)
+	}
+	if got := limits.String(); got != "BatchSearch=0.5:5,Search=20:40" {
+		t.Errorf("String() = %q", got)
+	}
+	for _, value := range []string{"Search=20", "Search:20:40", "Search=fast:40"} {
+		if err := limits.Set(value); err == nil {
+			t.Errorf("Set(%q) should fail", value)
+		}
+	}
+}
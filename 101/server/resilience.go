@@ -0,0 +1,284 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+)
+
+// RetryOptions controls how failed upstream calls are retried
+type RetryOptions struct {
+	// MaxAttempts is the total number of tries, 1 disables retries
+	MaxAttempts int `yaml:"max_attempts"`
+	// BaseDelay is the backoff before the first retry, doubled on each next one
+	BaseDelay time.Duration `yaml:"base_delay"`
+	// MaxDelay caps the backoff
+	MaxDelay time.Duration `yaml:"max_delay"`
+}
+
+// BreakerOptions controls when the circuit breaker opens and closes
+type BreakerOptions struct {
+	// FailureThreshold consecutive failures open the circuit
+	FailureThreshold int `yaml:"failure_threshold"`
+	// OpenTimeout is how long the circuit stays open before probing the upstream
+	OpenTimeout time.Duration `yaml:"open_timeout"`
+	// HalfOpenProbes is how many calls may probe the upstream at once
+	HalfOpenProbes int `yaml:"half_open_probes"`
+}
+
+// DefaultRetryOptions are used unless configured otherwise
+var DefaultRetryOptions = RetryOptions{
+	MaxAttempts: 3,
+	BaseDelay:   100 * time.Millisecond,
+	MaxDelay:    2 * time.Second,
+}
+
+// DefaultBreakerOptions are used unless configured otherwise
+var DefaultBreakerOptions = BreakerOptions{
+	FailureThreshold: 5,
+	OpenTimeout:      30 * time.Second,
+	HalfOpenProbes:   1,
+}
+
+// circuitOpenError is returned without calling the upstream while the circuit is open
+type circuitOpenError struct {
+	RetryAfter time.Duration
+}
+
+func (e *circuitOpenError) Error() string {
+	return "upstream is unavailable, circuit breaker is open"
+}
+
+// circuit breaker states
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "half-open"
+)
+
+// ResilienceStats are the counters exposed by ResilientSource
+type ResilienceStats struct {
+	State      string `json:"state"`
+	Retries    uint64 `json:"retries"`
+	Failures   uint64 `json:"failures"`
+	Opens      uint64 `json:"opens"`
+	Rejections uint64 `json:"rejections"`
+}
+
+// ResilientSource retries transient failures of another CountrySource
+// and stops calling it for a while once it keeps failing
+type ResilientSource struct {
+	source    CountrySource
+	retry     RetryOptions
+	breaker   BreakerOptions
+	now       func() time.Time
+	randDelay func(max time.Duration) time.Duration
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probes   int
+	stats    ResilienceStats
+}
+
+// NewResilientSource wraps source with retries and a circuit breaker
+func NewResilientSource(source CountrySource, retry RetryOptions, breaker BreakerOptions) *ResilientSource {
+	if retry.MaxAttempts < 1 {
+		retry.MaxAttempts = 1
+	}
+	if breaker.HalfOpenProbes < 1 {
+		breaker.HalfOpenProbes = 1
+	}
+	return &ResilientSource{
+		source:  source,
+		retry:   retry,
+		breaker: breaker,
+		now:     time.Now,
+		state:   circuitClosed,
+		randDelay: func(max time.Duration) time.Duration {
+			return time.Duration(rand.Int63n(int64(max) + 1))
+		},
+	}
+}
+
+// Search calls the wrapped source, retrying transient failures
+func (r *ResilientSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	return r.call(ctx, func() ([]*countries.CountryResponse, error) {
+		return r.source.Search(ctx, name)
+	})
+}
+
+// Lookup calls the wrapped source, retrying transient failures
+func (r *ResilientSource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	return r.call(ctx, func() ([]*countries.CountryResponse, error) {
+		return r.source.Lookup(ctx, by, value)
+	})
+}
+
+// Ping reports the upstream as unreachable while the circuit is open,
+// and otherwise asks the wrapped source
+func (r *ResilientSource) Ping(ctx context.Context) error {
+	if wait := r.openFor(); wait > 0 {
+		return &circuitOpenError{RetryAfter: wait}
+	}
+	if pinger, ok := r.source.(Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// Suggest asks the wrapped source for names close to name, unless the
+// circuit is open
+func (r *ResilientSource) Suggest(ctx context.Context, name string, max int) []string {
+	suggester, ok := r.source.(Suggester)
+	if !ok || r.openFor() > 0 {
+		return nil
+	}
+	return suggester.Suggest(ctx, name, max)
+}
+
+// openFor returns how long the circuit stays open, 0 when it is not
+func (r *ResilientSource) openFor() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state != circuitOpen {
+		return 0
+	}
+	if wait := r.openedAt.Add(r.breaker.OpenTimeout).Sub(r.now()); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+func (r *ResilientSource) call(ctx context.Context, fetch func() ([]*countries.CountryResponse, error)) ([]*countries.CountryResponse, error) {
+	var err error
+	for attempt := 0; attempt < r.retry.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := r.sleep(ctx, attempt); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.allow(); err != nil {
+			return nil, err
+		}
+		var data []*countries.CountryResponse
+		data, err = fetch()
+		r.record(ctx, err)
+		if !isTransient(err) || ctx.Err() != nil {
+			return data, err
+		}
+	}
+	return nil, err
+}
+
+// sleep waits out the backoff before the given retry, using full jitter
+func (r *ResilientSource) sleep(ctx context.Context, attempt int) error {
+	delay := r.retry.BaseDelay << (attempt - 1)
+	if delay > r.retry.MaxDelay || delay <= 0 {
+		delay = r.retry.MaxDelay
+	}
+	r.mu.Lock()
+	r.stats.Retries++
+	r.mu.Unlock()
+
+	timer := time.NewTimer(r.randDelay(delay))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// allow tells whether the upstream may be called right now
+func (r *ResilientSource) allow() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state == circuitOpen {
+		if wait := r.openedAt.Add(r.breaker.OpenTimeout).Sub(r.now()); wait > 0 {
+			r.stats.Rejections++
+			return &circuitOpenError{RetryAfter: wait}
+		}
+		r.state = circuitHalfOpen
+		r.probes = 0
+	}
+	if r.state == circuitHalfOpen {
+		if r.probes >= r.breaker.HalfOpenProbes {
+			// other calls are probing the upstream, their outcome is known soon
+			r.stats.Rejections++
+			return &circuitOpenError{RetryAfter: time.Second}
+		}
+		r.probes++
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of an upstream call
+func (r *ResilientSource) record(ctx context.Context, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if errors.Is(ctx.Err(), context.Canceled) {
+		// the caller gave up, which says nothing about the upstream, but a
+		// deadline running out while waiting for it is counted as a failure
+		if r.state == circuitHalfOpen {
+			r.probes--
+		}
+		return
+	}
+	if !isUpstreamFailure(err) {
+		r.state = circuitClosed
+		r.failures = 0
+		return
+	}
+	r.stats.Failures++
+	r.failures++
+	if r.state == circuitHalfOpen || r.failures >= r.breaker.FailureThreshold {
+		if r.state != circuitOpen {
+			r.stats.Opens++
+		}
+		r.state = circuitOpen
+		r.openedAt = r.now()
+	}
+}
+
+// Stats returns a snapshot of the retry and circuit breaker counters
+func (r *ResilientSource) Stats() ResilienceStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.stats
+	stats.State = r.state
+	return stats
+}
+
+// isTransient reports whether a failed call is worth retrying
+func isTransient(err error) bool {
+	var upstreamErr *upstreamError
+	switch {
+	case err == nil:
+		return false
+	case errors.As(err, &upstreamErr):
+		return upstreamErr.StatusCode >= http.StatusInternalServerError ||
+			upstreamErr.StatusCode == http.StatusTooManyRequests
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return true
+	default:
+		return false
+	}
+}
+
+// isUpstreamFailure reports whether err means the upstream is unhealthy,
+// as opposed to a normal answer like not found
+func isUpstreamFailure(err error) bool {
+	return isTransient(err) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakySource fails with a 503 until it is told to recover
+type flakySource struct {
+	CountrySource
+	failing bool
+	calls   int
+}
+
+func (s *flakySource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	s.calls++
+	if s.failing {
+		return nil, &upstreamError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	}
+	return []*countries.CountryResponse{{Name: name}}, nil
+}
+
+func TestResilientSource(t *testing.T) {
+	ctx := context.Background()
+	upstream := &flakySource{failing: true}
+	now := time.Unix(0, 0)
+	source := NewResilientSource(upstream,
+		RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		BreakerOptions{FailureThreshold: 5, OpenTimeout: time.Minute, HalfOpenProbes: 1},
+	)
+	source.now = func() time.Time { return now }
+
+	if _, err := source.Search(ctx, "Brazil"); err == nil {
+		t.Fatal("expected the upstream failure")
+	}
+	if upstream.calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", upstream.calls)
+	}
+
+	// two more failures reach the threshold and open the circuit
+	source.Search(ctx, "Brazil")
+	if stats := source.Stats(); stats.State != circuitOpen || stats.Opens != 1 {
+		t.Fatalf("expected an open circuit, got %+v", stats)
+	}
+	calls := upstream.calls
+	_, err := source.Search(ctx, "Brazil")
+	var circuitErr *circuitOpenError
+	if !errors.As(err, &circuitErr) || upstream.calls != calls {
+		t.Fatalf("expected a fast failure without calling the upstream, got %v", err)
+	}
+	if code := status.Code(toStatus(err, "Brazil")); code != codes.Unavailable {
+		t.Errorf("expected Unavailable, got %v", code)
+	}
+	if err := source.Ping(ctx); !errors.As(err, &circuitErr) {
+		t.Errorf("expected Ping to report the open circuit, got %v", err)
+	}
+
+	// after the open timeout a probe goes through and closes the circuit again
+	upstream.failing = false
+	now = now.Add(2 * time.Minute)
+	if _, err := source.Search(ctx, "Brazil"); err != nil {
+		t.Fatalf("expected the probe to succeed, got %v", err)
+	}
+	if stats := source.Stats(); stats.State != circuitClosed || stats.Retries != 4 || stats.Rejections != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestResilientSourceHungUpstream(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer upstream.Close()
+	source := NewResilientSource(NewHTTPSource(upstream.URL, DefaultHTTPOptions),
+		RetryOptions{MaxAttempts: 1},
+		BreakerOptions{FailureThreshold: 3, OpenTimeout: time.Minute, HalfOpenProbes: 1},
+	)
+
+	// callers with deadlines shorter than the upstream timeout still open the circuit
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		source.Search(ctx, "Brazil")
+		cancel()
+	}
+	if stats := source.Stats(); stats.State != circuitOpen || stats.Failures != 3 {
+		t.Errorf("expected the hung upstream to open the circuit, got %+v", stats)
+	}
+
+	// callers canceling say nothing about the upstream
+	canceled := NewResilientSource(NewHTTPSource(upstream.URL, DefaultHTTPOptions),
+		RetryOptions{MaxAttempts: 1},
+		BreakerOptions{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenProbes: 1},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	canceled.Search(ctx, "Brazil")
+	if stats := canceled.Stats(); stats.State != circuitClosed || stats.Failures != 0 {
+		t.Errorf("expected a canceled call not to count, got %+v", stats)
+	}
+}
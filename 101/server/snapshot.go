@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotStats are the counters exposed by SnapshotSource
+type SnapshotStats struct {
+	Countries       int       `json:"countries"`
+	LoadedAt        time.Time `json:"loaded_at"`
+	Refreshes       uint64    `json:"refreshes"`
+	RefreshFailures uint64    `json:"refresh_failures"`
+}
+
+// SnapshotSource serves countries from a full dataset held in memory.
+// Refresh loads a new dataset and swaps it in at once, so searches
+// always see either the old or the new dataset, never a mix.
+type SnapshotSource struct {
+	load  func(ctx context.Context) ([]*countries.CountryResponse, error)
+	index atomic.Value // *countryIndex
+
+	mu    sync.Mutex
+	stats SnapshotStats
+}
+
+// NewSnapshotSource loads the first dataset, which must succeed
+func NewSnapshotSource(ctx context.Context, load func(ctx context.Context) ([]*countries.CountryResponse, error)) (*SnapshotSource, error) {
+	s := &SnapshotSource{load: load}
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Refresh loads the dataset again and swaps it in once fully indexed
+func (s *SnapshotSource) Refresh(ctx context.Context) error {
+	data, err := s.load(ctx)
+	if err == nil && len(data) == 0 {
+		err = fmt.Errorf("dataset is empty")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.stats.RefreshFailures++
+		return err
+	}
+	s.index.Store(newCountryIndex(data))
+	s.stats.Refreshes++
+	s.stats.Countries = len(data)
+	s.stats.LoadedAt = time.Now()
+	return nil
+}
+
+// Run refreshes the dataset every interval until ctx is done, keeping
+// the current dataset when a refresh fails
+func (s *SnapshotSource) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("could not refresh the country dataset %v", err)
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the dataset counters
+func (s *SnapshotSource) Stats() SnapshotStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// Search returns exact name matches first, then names starting with name,
+// then names containing it
+func (s *SnapshotSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	return found(s.index.Load().(*countryIndex).search(name))
+}
+
+// Suggest returns the country names closest to name
+func (s *SnapshotSource) Suggest(ctx context.Context, name string, max int) []string {
+	return suggest(name, s.index.Load().(*countryIndex).suggestions, max)
+}
+
+// Lookup returns the countries whose field by matches value
+func (s *SnapshotSource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	data, err := s.index.Load().(*countryIndex).lookup(by, value)
+	if err != nil {
+		return nil, err
+	}
+	return found(data)
+}
+
+// found copies data out of the index, or reports that nothing matched
+func found(data []*countries.CountryResponse) ([]*countries.CountryResponse, error) {
+	if len(data) == 0 {
+		return nil, errCountryNotFound
+	}
+	out := make([]*countries.CountryResponse, len(data))
+	for i, c := range data {
+		out[i] = proto.Clone(c).(*countries.CountryResponse)
+	}
+	return out, nil
+}
+
+// countryIndex is an immutable dataset, indexed for the lookups the server does
+type countryIndex struct {
+	countries   []*countries.CountryResponse
+	names       []indexedName // sorted by key
+	suggestions map[string]string
+	byCode      map[string]*countries.CountryResponse
+	byCurrency  map[string][]*countries.CountryResponse
+	byRegion    map[string][]*countries.CountryResponse
+	bySubregion map[string][]*countries.CountryResponse
+}
+
+// indexedName is the folded name or native name of a country
+type indexedName struct {
+	key     string
+	country int
+}
+
+func newCountryIndex(data []*countries.CountryResponse) *countryIndex {
+	index := &countryIndex{
+		countries:   data,
+		suggestions: make(map[string]string),
+		byCode:      make(map[string]*countries.CountryResponse),
+		byCurrency:  make(map[string][]*countries.CountryResponse),
+		byRegion:    make(map[string][]*countries.CountryResponse),
+		bySubregion: make(map[string][]*countries.CountryResponse),
+	}
+	for i, c := range data {
+		name, nativeName := foldName(c.Name), foldName(c.NativeName)
+		index.names = append(index.names, indexedName{key: name, country: i})
+		index.suggestions[name] = c.Name
+		if nativeName != "" && nativeName != name {
+			index.names = append(index.names, indexedName{key: nativeName, country: i})
+			index.suggestions[nativeName] = c.Name
+		}
+		index.byCode[strings.ToLower(c.Alpha2Code)] = c
+		index.byCode[strings.ToLower(c.Alpha3Code)] = c
+		for _, currency := range c.Currencies {
+			key := strings.ToLower(currency.Code)
+			index.byCurrency[key] = append(index.byCurrency[key], c)
+		}
+		index.byRegion[strings.ToLower(c.Region)] = append(index.byRegion[strings.ToLower(c.Region)], c)
+		index.bySubregion[strings.ToLower(c.Subregion)] = append(index.bySubregion[strings.ToLower(c.Subregion)], c)
+	}
+	sort.Slice(index.names, func(i, j int) bool {
+		return index.names[i].key < index.names[j].key
+	})
+	return index
+}
+
+func (index *countryIndex) search(name string) []*countries.CountryResponse {
+	query := foldName(name)
+	if query == "" {
+		return nil
+	}
+	seen := make(map[int]bool)
+	var exact, prefix, partial []*countries.CountryResponse
+	add := func(list *[]*countries.CountryResponse, country int) {
+		if !seen[country] {
+			seen[country] = true
+			*list = append(*list, index.countries[country])
+		}
+	}
+
+	// names sharing the query as prefix are contiguous in the sorted index
+	start := sort.Search(len(index.names), func(i int) bool {
+		return index.names[i].key >= query
+	})
+	for i := start; i < len(index.names) && strings.HasPrefix(index.names[i].key, query); i++ {
+		if index.names[i].key == query {
+			add(&exact, index.names[i].country)
+		}
+	}
+	for i := start; i < len(index.names) && strings.HasPrefix(index.names[i].key, query); i++ {
+		add(&prefix, index.names[i].country)
+	}
+	for _, n := range index.names {
+		if strings.Contains(n.key, query) {
+			add(&partial, n.country)
+		}
+	}
+	return append(append(exact, prefix...), partial...)
+}
+
+func (index *countryIndex) lookup(by, value string) ([]*countries.CountryResponse, error) {
+	key := strings.ToLower(strings.TrimSpace(value))
+	switch by {
+	case byCode:
+		if c, ok := index.byCode[key]; ok && key != "" {
+			return []*countries.CountryResponse{c}, nil
+		}
+		return nil, nil
+	case byCurrency:
+		return index.byCurrency[key], nil
+	case byRegion:
+		return index.byRegion[key], nil
+	case bySubregion:
+		return index.bySubregion[key], nil
+	case byCapital:
+		var data []*countries.CountryResponse
+		for _, c := range index.countries {
+			if key != "" && strings.Contains(strings.ToLower(c.Capital), key) {
+				data = append(data, c)
+			}
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unknown lookup field %q", by)
+	}
+}
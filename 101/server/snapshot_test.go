@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+)
+
+func loadTestdata(ctx context.Context) ([]*countries.CountryResponse, error) {
+	return readCountries("testdata/countries.json")
+}
+
+func TestSnapshotSearch(t *testing.T) {
+	ctx := context.Background()
+	snapshot, err := NewSnapshotSource(ctx, loadTestdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		// Guinea Ecuatorial is a native name starting with the query
+		{"guinea", []string{"Guinea", "Equatorial Guinea", "Guinea-Bissau", "Papua New Guinea"}},
+		{"Ger", []string{"Germany"}},
+		{"deutschland", []string{"Germany"}},
+		{" GERMANY ", []string{"Germany"}},
+		{"日本", []string{"Japan"}},
+	}
+	for _, test := range tests {
+		data, err := snapshot.Search(ctx, test.name)
+		if err != nil {
+			t.Fatalf("Search(%q) failed %v", test.name, err)
+		}
+		var names []string
+		for _, c := range data {
+			names = append(names, c.Name)
+		}
+		if len(names) != len(test.want) {
+			t.Fatalf("Search(%q) = %v, want %v", test.name, names, test.want)
+		}
+		for i := range names {
+			if names[i] != test.want[i] {
+				t.Errorf("Search(%q) = %v, want %v", test.name, names, test.want)
+				break
+			}
+		}
+	}
+
+	if _, err := snapshot.Search(ctx, "Atlantis"); err != errCountryNotFound {
+		t.Errorf("expected errCountryNotFound, got %v", err)
+	}
+	data, err := snapshot.Lookup(ctx, byCode, "bra")
+	if err != nil || data[0].Name != "Brazil" {
+		t.Errorf("Lookup(alpha, bra) = %v, %v", data, err)
+	}
+	data, err = snapshot.Lookup(ctx, byCurrency, "EUR")
+	if err != nil || len(data) != 2 {
+		t.Errorf("Lookup(currency, EUR) = %v, %v", data, err)
+	}
+}
+
+func TestSnapshotRefresh(t *testing.T) {
+	ctx := context.Background()
+	dataset := []*countries.CountryResponse{{Name: "Brazil", Alpha2Code: "BR"}}
+	var loadErr error
+	snapshot, err := NewSnapshotSource(ctx, func(ctx context.Context) ([]*countries.CountryResponse, error) {
+		return dataset, loadErr
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a failed refresh keeps serving the previous dataset
+	loadErr = errors.New("upstream is down")
+	if err := snapshot.Refresh(ctx); err != loadErr {
+		t.Fatalf("expected the load error, got %v", err)
+	}
+	if _, err := snapshot.Search(ctx, "Brazil"); err != nil {
+		t.Errorf("previous dataset is gone %v", err)
+	}
+
+	loadErr = nil
+	dataset = []*countries.CountryResponse{{Name: "Germany", Alpha2Code: "DE"}}
+	if err := snapshot.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := snapshot.Search(ctx, "Brazil"); err != errCountryNotFound {
+		t.Errorf("expected Brazil to be gone, got %v", err)
+	}
+	if _, err := snapshot.Search(ctx, "Germany"); err != nil {
+		t.Errorf("expected Germany after refresh, got %v", err)
+	}
+
+	stats := snapshot.Stats()
+	if stats.Countries != 1 || stats.Refreshes != 2 || stats.RefreshFailures != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestSnapshotConcurrentRefresh(t *testing.T) {
+	ctx := context.Background()
+	snapshot, err := NewSnapshotSource(ctx, loadTestdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				snapshot.Refresh(ctx)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if data, err := snapshot.Search(ctx, "guinea"); err != nil || len(data) != 4 {
+					t.Errorf("Search(guinea) during refresh = %d countries, %v", len(data), err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
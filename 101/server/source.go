@@ -0,0 +1,420 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// errCountryNotFound is returned by a CountrySource when no country matches
+var errCountryNotFound = errors.New("country not found")
+
+// upstreamError reports an unexpected HTTP status from the upstream API
+type upstreamError struct {
+	StatusCode int
+	Status     string
+	RetryAfter time.Duration
+}
+
+func (e *upstreamError) Error() string {
+	return "upstream returned " + e.Status
+}
+
+// payloadError reports an upstream answer that could not be decoded
+type payloadError struct {
+	err error
+}
+
+func (e *payloadError) Error() string {
+	return "invalid upstream payload: " + e.err.Error()
+}
+
+func (e *payloadError) Unwrap() error {
+	return e.err
+}
+
+// Fields a CountrySource can look countries up by, named after
+// the restcountries endpoints serving them
+const (
+	byCode      = "alpha"
+	byCurrency  = "currency"
+	byCapital   = "capital"
+	byRegion    = "region"
+	bySubregion = "subregion"
+)
+
+// CountrySource is where the server gets its country data from
+type CountrySource interface {
+	// Search returns every country whose name matches the given one
+	Search(ctx context.Context, name string) ([]*countries.CountryResponse, error)
+	// Lookup returns every country whose field by matches value
+	Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error)
+}
+
+// NewSource builds the CountrySource selected at startup
+func NewSource(ctx context.Context, cfg Config) (CountrySource, error) {
+	switch cfg.Source {
+	case "http":
+		return NewHTTPSource(cfg.Upstream.URL, cfg.Upstream.HTTPOptions), nil
+	case "file":
+		return LoadFileSource(cfg.DataFile)
+	case "snapshot":
+		switch cfg.Snapshot.From {
+		case "http":
+			return NewSnapshotSource(ctx, NewHTTPSource(cfg.Upstream.URL, cfg.Upstream.HTTPOptions).All)
+		case "file":
+			return NewSnapshotSource(ctx, func(ctx context.Context) ([]*countries.CountryResponse, error) {
+				return readCountries(cfg.DataFile)
+			})
+		default:
+			return nil, fmt.Errorf("unknown snapshot origin %q", cfg.Snapshot.From)
+		}
+	case "aggregate":
+		providers := make([]Provider, len(cfg.Providers))
+		for i, p := range cfg.Providers {
+			provider, err := newProvider(p, cfg)
+			if err != nil {
+				return nil, err
+			}
+			providers[i] = Provider{Name: p.Name, Source: provider}
+		}
+		return NewAggregateSource(cfg.ProviderTimeout, providers...), nil
+	default:
+		return nil, fmt.Errorf("unknown country source %q", cfg.Source)
+	}
+}
+
+// newProvider builds a provider of the aggregate source, the HTTP ones
+// retrying and breaking their circuit on their own
+func newProvider(p ProviderConfig, cfg Config) (CountrySource, error) {
+	switch p.Kind {
+	case "v2", "v3":
+		options := cfg.Upstream.HTTPOptions
+		options.API = p.Kind
+		return NewResilientSource(NewHTTPSource(p.URL, options), cfg.Retry, cfg.Breaker), nil
+	case "file":
+		return LoadFileSource(p.Data)
+	default:
+		return nil, fmt.Errorf("unknown kind %q of provider %s", p.Kind, p.Name)
+	}
+}
+
+// HTTPOptions tunes how HTTPSource talks to the upstream API
+type HTTPOptions struct {
+	// API is the restcountries API version served at the base URL, v2 or v3
+	API string `yaml:"api"`
+	// Timeout bounds every upstream request, unless the caller's deadline is sooner
+	Timeout time.Duration `yaml:"timeout"`
+	// DialTimeout bounds establishing a new connection
+	DialTimeout time.Duration `yaml:"dial_timeout"`
+	// MaxIdleConns is the size of the idle connection pool
+	MaxIdleConns int `yaml:"max_idle_conns"`
+	// MaxConnsPerHost caps the connections opened to the upstream, 0 means no limit
+	MaxConnsPerHost int `yaml:"max_conns"`
+	// IdleConnTimeout closes pooled connections unused for that long
+	IdleConnTimeout time.Duration `yaml:"idle_timeout"`
+	// NamesRefresh is how long the country names suggested for searches
+	// matching nothing are kept before being fetched again, 0 disables suggestions
+	NamesRefresh time.Duration `yaml:"names_refresh"`
+}
+
+// DefaultHTTPOptions are used unless configured otherwise
+var DefaultHTTPOptions = HTTPOptions{
+	API:             "v2",
+	Timeout:         10 * time.Second,
+	DialTimeout:     5 * time.Second,
+	MaxIdleConns:    100,
+	MaxConnsPerHost: 0,
+	IdleConnTimeout: 90 * time.Second,
+	NamesRefresh:    24 * time.Hour,
+}
+
+// namesRetryDelay is how long HTTPSource waits before fetching the
+// country names again after failing to
+const namesRetryDelay = time.Minute
+
+// HTTPSource reads countries from the restcountries.com v2 or v3 REST API
+type HTTPSource struct {
+	baseURL      string
+	api          string
+	timeout      time.Duration
+	namesRefresh time.Duration
+	client       *http.Client
+
+	mu         sync.Mutex
+	names      map[string]string // folded name to country name, see suggest
+	namesAt    time.Time
+	namesTried time.Time
+	refreshing bool
+}
+
+// NewHTTPSource creates a source talking to the given API base URL
+// through a connection pool of its own
+func NewHTTPSource(baseURL string, options HTTPOptions) *HTTPSource {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   options.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        options.MaxIdleConns,
+		MaxIdleConnsPerHost: options.MaxIdleConns,
+		MaxConnsPerHost:     options.MaxConnsPerHost,
+		IdleConnTimeout:     options.IdleConnTimeout,
+		TLSHandshakeTimeout: options.DialTimeout,
+	}
+	return &HTTPSource{
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		api:          options.API,
+		timeout:      options.Timeout,
+		namesRefresh: options.NamesRefresh,
+		client:       &http.Client{Transport: transport},
+	}
+}
+
+// Search asks the upstream API for countries matching name
+func (s *HTTPSource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	return s.get(ctx, "/name/"+url.PathEscape(name), false)
+}
+
+// Lookup asks the upstream endpoint serving by for countries matching value
+func (s *HTTPSource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	// the v2 alpha endpoint answers with a single country instead of a list
+	return s.get(ctx, "/"+by+"/"+url.PathEscape(value), by == byCode && s.api != "v3")
+}
+
+// All fetches the whole upstream dataset
+func (s *HTTPSource) All(ctx context.Context) ([]*countries.CountryResponse, error) {
+	return s.get(ctx, "/all", false)
+}
+
+// Suggest returns the country names closest to name, out of the names and
+// native names of every country. They are fetched on the first call and
+// again on the first call after NamesRefresh, by a single caller at a time
+// while the others rank against the names already known.
+func (s *HTTPSource) Suggest(ctx context.Context, name string, max int) []string {
+	if s.namesRefresh <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	now := time.Now()
+	names := s.names
+	refresh := !s.refreshing && (names == nil || now.Sub(s.namesAt) >= s.namesRefresh) && now.Sub(s.namesTried) >= namesRetryDelay
+	if refresh {
+		s.refreshing, s.namesTried = true, now
+	}
+	s.mu.Unlock()
+
+	if refresh {
+		fetched, err := s.fetchNames(ctx)
+		s.mu.Lock()
+		s.refreshing = false
+		if err == nil {
+			s.names, s.namesAt = fetched, now
+			names = fetched
+		}
+		s.mu.Unlock()
+	}
+	// names maps are replaced, never modified, so ranking needs no lock
+	return suggest(name, names, max)
+}
+
+// fetchNames fetches the names and native names of every country
+func (s *HTTPSource) fetchNames(ctx context.Context) (map[string]string, error) {
+	path := "/all?fields=name,nativeName"
+	if s.api == "v3" {
+		path = "/all?fields=name"
+	}
+	jsonData, err := s.fetch(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeNames(jsonData, s.api == "v3")
+}
+
+func (s *HTTPSource) get(ctx context.Context, path string, single bool) ([]*countries.CountryResponse, error) {
+	jsonData, err := s.fetch(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	decode := decodeCountries
+	if s.api == "v3" {
+		decode = decodeCountriesV3
+	}
+	data, err := decode(jsonData, single)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errCountryNotFound
+	}
+	return data, nil
+}
+
+// fetch reads the upstream answer to a GET of path
+func (s *HTTPSource) fetch(ctx context.Context, path string) ([]byte, error) {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errCountryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &upstreamError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// Ping checks that the upstream API answers, any answer short of a
+// server error counts
+func (s *HTTPSource) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/alpha/us?fields=name", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &upstreamError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	return nil
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// MemorySource serves countries from an in-memory list
+type MemorySource struct {
+	countries []*countries.CountryResponse
+}
+
+// NewMemorySource creates a source holding the given countries
+func NewMemorySource(list ...*countries.CountryResponse) *MemorySource {
+	return &MemorySource{countries: list}
+}
+
+// LoadFileSource reads a restcountries-style JSON dump so the server can run offline
+func LoadFileSource(path string) (*MemorySource, error) {
+	data, err := readCountries(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewMemorySource(data...), nil
+}
+
+// readCountries reads a restcountries-style JSON dump
+func readCountries(path string) ([]*countries.CountryResponse, error) {
+	jsonData, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeCountries(jsonData, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", path, err)
+	}
+	return data, nil
+}
+
+// Search matches name against part of the country name or native name,
+// ignoring case and accents
+func (s *MemorySource) Search(ctx context.Context, name string) ([]*countries.CountryResponse, error) {
+	name = foldName(name)
+	return s.filter(func(c *countries.CountryResponse) bool {
+		return strings.Contains(foldName(c.Name), name) ||
+			strings.Contains(foldName(c.NativeName), name)
+	})
+}
+
+// Suggest returns the country names closest to name
+func (s *MemorySource) Suggest(ctx context.Context, name string, max int) []string {
+	candidates := make(map[string]string)
+	for _, c := range s.countries {
+		candidates[foldName(c.Name)] = c.Name
+		if c.NativeName != "" {
+			candidates[foldName(c.NativeName)] = c.Name
+		}
+	}
+	return suggest(name, candidates, max)
+}
+
+// Lookup matches value against the field by the same way the upstream endpoints do
+func (s *MemorySource) Lookup(ctx context.Context, by, value string) ([]*countries.CountryResponse, error) {
+	var match func(c *countries.CountryResponse) bool
+	switch by {
+	case byCode:
+		match = func(c *countries.CountryResponse) bool {
+			return strings.EqualFold(c.Alpha2Code, value) || strings.EqualFold(c.Alpha3Code, value)
+		}
+	case byCurrency:
+		match = func(c *countries.CountryResponse) bool {
+			for _, currency := range c.Currencies {
+				if strings.EqualFold(currency.Code, value) {
+					return true
+				}
+			}
+			return false
+		}
+	case byCapital:
+		value = strings.ToLower(value)
+		match = func(c *countries.CountryResponse) bool {
+			return strings.Contains(strings.ToLower(c.Capital), value)
+		}
+	case byRegion:
+		match = func(c *countries.CountryResponse) bool { return strings.EqualFold(c.Region, value) }
+	case bySubregion:
+		match = func(c *countries.CountryResponse) bool { return strings.EqualFold(c.Subregion, value) }
+	default:
+		return nil, fmt.Errorf("unknown lookup field %q", by)
+	}
+	return s.filter(match)
+}
+
+func (s *MemorySource) filter(match func(c *countries.CountryResponse) bool) ([]*countries.CountryResponse, error) {
+	var data []*countries.CountryResponse
+	for _, c := range s.countries {
+		if match(c) {
+			data = append(data, proto.Clone(c).(*countries.CountryResponse))
+		}
+	}
+	if len(data) == 0 {
+		return nil, errCountryNotFound
+	}
+	return data, nil
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"server/countries"
+)
+
+// upstreamCountry is a country as the restcountries v2 API describes it.
+// It is decoded and validated on its own, so a change of the upstream
+// schema is reported instead of leaking into CountryResponse.
+type upstreamCountry struct {
+	Name         string             `json:"name"`
+	NativeName   string             `json:"nativeName"`
+	Alpha2Code   string             `json:"alpha2Code"`
+	Alpha3Code   string             `json:"alpha3Code"`
+	Capital      string             `json:"capital"`
+	Region       string             `json:"region"`
+	Subregion    string             `json:"subregion"`
+	Population   *int64             `json:"population"`
+	Area         *float64           `json:"area"`
+	Latlng       []float64          `json:"latlng"`
+	Currencies   []upstreamCurrency `json:"currencies"`
+	Languages    []upstreamLanguage `json:"languages"`
+	Borders      []string           `json:"borders"`
+	Timezones    []string           `json:"timezones"`
+	CallingCodes []string           `json:"callingCodes"`
+	Flag         string             `json:"flag"`
+}
+
+type upstreamCurrency struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type upstreamLanguage struct {
+	ISO639_1   string `json:"iso639_1"`
+	ISO639_2   string `json:"iso639_2"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName"`
+	// ISO639_3 is not part of v2, v3 countries give it instead of the others
+	ISO639_3 string `json:"-"`
+}
+
+// schemaError reports an upstream field that is missing or does not
+// hold what the server expects
+type schemaError struct {
+	Field   string
+	Problem string
+}
+
+func (e *schemaError) Error() string {
+	return e.Field + ": " + e.Problem
+}
+
+// decodeCountries decodes and validates a list of upstream countries,
+// or a single one when single is set
+func decodeCountries(jsonData []byte, single bool) ([]*countries.CountryResponse, error) {
+	var list []upstreamCountry
+	if single {
+		list = make([]upstreamCountry, 1)
+		if err := json.Unmarshal(jsonData, &list[0]); err != nil {
+			return nil, decodeError(err)
+		}
+	} else if err := json.Unmarshal(jsonData, &list); err != nil {
+		return nil, decodeError(err)
+	}
+	return mapCountries(list, single)
+}
+
+// decodeCountriesV3 decodes and validates a list of countries in the
+// restcountries v3 format, which always answers with a list
+func decodeCountriesV3(jsonData []byte, single bool) ([]*countries.CountryResponse, error) {
+	var v3 []upstreamCountryV3
+	if err := json.Unmarshal(jsonData, &v3); err != nil {
+		return nil, decodeError(err)
+	}
+	list := make([]upstreamCountry, len(v3))
+	for i := range v3 {
+		list[i] = v3[i].toV2()
+	}
+	return mapCountries(list, false)
+}
+
+// decodeNames decodes the names and native names of a v2 or v3 list of
+// countries fetched with only those fields, keyed by their folded form
+func decodeNames(jsonData []byte, v3 bool) (map[string]string, error) {
+	var list []upstreamCountry
+	if v3 {
+		var v3List []upstreamCountryV3
+		if err := json.Unmarshal(jsonData, &v3List); err != nil {
+			return nil, decodeError(err)
+		}
+		for i := range v3List {
+			list = append(list, v3List[i].toV2())
+		}
+	} else if err := json.Unmarshal(jsonData, &list); err != nil {
+		return nil, decodeError(err)
+	}
+	names := make(map[string]string)
+	for _, c := range list {
+		if c.Name == "" {
+			continue
+		}
+		names[foldName(c.Name)] = c.Name
+		if c.NativeName != "" {
+			names[foldName(c.NativeName)] = c.Name
+		}
+	}
+	return names, nil
+}
+
+// mapCountries validates upstream countries and maps them to messages
+func mapCountries(list []upstreamCountry, single bool) ([]*countries.CountryResponse, error) {
+	data := make([]*countries.CountryResponse, len(list))
+	for i := range list {
+		if err := list[i].validate(); err != nil {
+			if !single {
+				err.Field = fmt.Sprintf("[%d].%s", i, err.Field)
+			}
+			return nil, &payloadError{err: err}
+		}
+		data[i] = list[i].toProto()
+	}
+	return data, nil
+}
+
+// decodeError turns a JSON type mismatch into a schemaError naming the field
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		err = &schemaError{
+			Field:   fieldPath(typeErr.Field),
+			Problem: fmt.Sprintf("expected %s, got %s", typeErr.Type, typeErr.Value),
+		}
+	}
+	return &payloadError{err: err}
+}
+
+// fieldPath writes the dotted path of a JSON field like "0.currencies.1.code"
+// the way validate names fields, "[0].currencies[1].code"
+func fieldPath(dotted string) string {
+	var path strings.Builder
+	for _, part := range strings.Split(dotted, ".") {
+		if _, err := strconv.Atoi(part); err == nil {
+			path.WriteString("[" + part + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(part)
+	}
+	return path.String()
+}
+
+// validate checks the fields the server relies on
+func (c *upstreamCountry) validate() *schemaError {
+	switch {
+	case c.Name == "":
+		return &schemaError{Field: "name", Problem: "is missing"}
+	case utf8.RuneCountInString(c.Alpha2Code) != 2:
+		return &schemaError{Field: "alpha2Code", Problem: fmt.Sprintf("%q is not an alpha-2 code", c.Alpha2Code)}
+	case c.Alpha3Code != "" && utf8.RuneCountInString(c.Alpha3Code) != 3:
+		return &schemaError{Field: "alpha3Code", Problem: fmt.Sprintf("%q is not an alpha-3 code", c.Alpha3Code)}
+	case c.Population != nil && *c.Population < 0:
+		return &schemaError{Field: "population", Problem: "is negative"}
+	case c.Area != nil && *c.Area < 0:
+		return &schemaError{Field: "area", Problem: "is negative"}
+	case len(c.Latlng) != 0 && len(c.Latlng) != 2:
+		return &schemaError{Field: "latlng", Problem: fmt.Sprintf("has %d coordinates instead of 2", len(c.Latlng))}
+	}
+	for i, currency := range c.Currencies {
+		// the API lists a few currencies by name only
+		if currency.Code == "" && currency.Name == "" {
+			return &schemaError{Field: fmt.Sprintf("currencies[%d]", i), Problem: "has neither code nor name"}
+		}
+	}
+	return nil
+}
+
+// toProto maps a validated upstream country to the message the server answers with
+func (c *upstreamCountry) toProto() *countries.CountryResponse {
+	country := &countries.CountryResponse{
+		Name:         c.Name,
+		NativeName:   c.NativeName,
+		Alpha2Code:   c.Alpha2Code,
+		Alpha3Code:   c.Alpha3Code,
+		Capital:      c.Capital,
+		Region:       c.Region,
+		Subregion:    c.Subregion,
+		Latlng:       c.Latlng,
+		Borders:      c.Borders,
+		Timezones:    c.Timezones,
+		CallingCodes: c.CallingCodes,
+		Flag:         c.Flag,
+	}
+	if c.Population != nil {
+		country.Population = *c.Population
+	}
+	if c.Area != nil {
+		country.Area = *c.Area
+	}
+	for _, currency := range c.Currencies {
+		country.Currencies = append(country.Currencies, &countries.Currencies{
+			Code:   currency.Code,
+			Name:   currency.Name,
+			Symbol: currency.Symbol,
+		})
+	}
+	for _, language := range c.Languages {
+		country.Languages = append(country.Languages, &countries.Languages{
+			Iso639_1:   language.ISO639_1,
+			Iso639_2:   language.ISO639_2,
+			Iso639_3:   language.ISO639_3,
+			Name:       language.Name,
+			NativeName: language.NativeName,
+		})
+	}
+	return country
+}
+
+// upstreamCountryV3 is a country as the restcountries v3 API describes it
+type upstreamCountryV3 struct {
+	Name struct {
+		Common     string `json:"common"`
+		NativeName map[string]struct {
+			Common string `json:"common"`
+		} `json:"nativeName"`
+	} `json:"name"`
+	CCA2       string    `json:"cca2"`
+	CCA3       string    `json:"cca3"`
+	Capital    []string  `json:"capital"`
+	Region     string    `json:"region"`
+	Subregion  string    `json:"subregion"`
+	Population *int64    `json:"population"`
+	Area       *float64  `json:"area"`
+	Latlng     []float64 `json:"latlng"`
+	Currencies map[string]struct {
+		Name   string `json:"name"`
+		Symbol string `json:"symbol"`
+	} `json:"currencies"`
+	// Languages maps ISO 639-3 codes to English names
+	Languages map[string]string `json:"languages"`
+	Borders   []string          `json:"borders"`
+	Timezones []string          `json:"timezones"`
+	IDD       struct {
+		Root     string   `json:"root"`
+		Suffixes []string `json:"suffixes"`
+	} `json:"idd"`
+	Flags struct {
+		SVG string `json:"svg"`
+	} `json:"flags"`
+}
+
+// toV2 maps a v3 country to the v2 shape, so both are validated and
+// mapped to messages the same way. Maps are read in key order to keep
+// the result stable.
+func (c *upstreamCountryV3) toV2() upstreamCountry {
+	country := upstreamCountry{
+		Name:       c.Name.Common,
+		Alpha2Code: c.CCA2,
+		Alpha3Code: c.CCA3,
+		Region:     c.Region,
+		Subregion:  c.Subregion,
+		Population: c.Population,
+		Area:       c.Area,
+		Latlng:     c.Latlng,
+		Borders:    c.Borders,
+		Timezones:  c.Timezones,
+		Flag:       c.Flags.SVG,
+	}
+	if len(c.Capital) > 0 {
+		country.Capital = c.Capital[0]
+	}
+	for _, language := range sortedKeys(c.Name.NativeName) {
+		country.NativeName = c.Name.NativeName[language].Common
+		break
+	}
+	for _, code := range sortedKeys(c.Currencies) {
+		currency := c.Currencies[code]
+		country.Currencies = append(country.Currencies, upstreamCurrency{Code: code, Name: currency.Name, Symbol: currency.Symbol})
+	}
+	for _, code := range sortedKeys(c.Languages) {
+		country.Languages = append(country.Languages, upstreamLanguage{ISO639_3: code, Name: c.Languages[code]})
+	}
+	// v2 lists the shared prefix of countries with many calling codes, like 1 for the US
+	root := strings.TrimPrefix(c.IDD.Root, "+")
+	switch {
+	case len(c.IDD.Suffixes) == 1:
+		country.CallingCodes = []string{root + c.IDD.Suffixes[0]}
+	case root != "":
+		country.CallingCodes = []string{root}
+	}
+	return country
+}
+
+// sortedKeys returns the keys of a JSON object decoded into a map
+func sortedKeys(m interface{}) []string {
+	value := reflect.ValueOf(m)
+	keys := make([]string, 0, value.Len())
+	for _, key := range value.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"server/countries"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodeCountries(t *testing.T) {
+	tests := []struct {
+		desc   string
+		json   string
+		single bool
+		field  string // set when decoding must fail on it
+	}{
+		{
+			desc: "valid",
+			json: `[{"name": "China", "alpha2Code": "CN", "alpha3Code": "CHN", "population": 3000000000, "latlng": [35, 105]}]`,
+		},
+		{
+			desc:   "single",
+			json:   `{"name": "China", "alpha2Code": "CN"}`,
+			single: true,
+		},
+		{
+			desc:  "population turned into a string",
+			json:  `[{"name": "China", "alpha2Code": "CN", "population": "1.4 billion"}]`,
+			field: "population",
+		},
+		{
+			desc:  "name moved into an object",
+			json:  `[{"name": {"common": "China"}, "alpha2Code": "CN"}]`,
+			field: "name",
+		},
+		{
+			desc:  "code renamed",
+			json:  `[{"name": "China", "cca2": "CN"}]`,
+			field: "[0].alpha2Code",
+		},
+		{
+			desc:  "negative population",
+			json:  `[{"name": "Brazil", "alpha2Code": "BR"}, {"name": "China", "alpha2Code": "CN", "population": -1}]`,
+			field: "[1].population",
+		},
+		{
+			desc:   "missing name",
+			json:   `{"alpha2Code": "CN"}`,
+			single: true,
+			field:  "name",
+		},
+	}
+	for _, test := range tests {
+		data, err := decodeCountries([]byte(test.json), test.single)
+		if test.field == "" {
+			if err != nil || len(data) != 1 || data[0].Name != "China" {
+				t.Errorf("%s: decodeCountries = %v, %v", test.desc, data, err)
+			}
+			continue
+		}
+		var info *errdetails.ErrorInfo
+		if details := status.Convert(toStatus(err, "China")).Details(); len(details) == 1 {
+			info, _ = details[0].(*errdetails.ErrorInfo)
+		}
+		// older Go versions leave the array index out of type errors
+		if info == nil || !strings.HasSuffix(info.Metadata["field"], test.field) {
+			t.Errorf("%s: expected a schema error on %s, got %v", test.desc, test.field, err)
+		}
+	}
+
+	data, _ := decodeCountries([]byte(tests[0].json), false)
+	if data[0].Population != 3000000000 || data[0].Latlng[1] != 105 {
+		t.Errorf("fields were not mapped: %v", data[0])
+	}
+}
+
+func TestSearchSchemaDrift(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name": {"common": "Brazil"}, "cca2": "BR"}]`)
+	}))
+	defer upstream.Close()
+	server := &Server{source: NewHTTPSource(upstream.URL, DefaultHTTPOptions)}
+
+	_, err := server.Search(context.Background(), &countries.CountryRequest{Name: "Brazil"})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+	details := status.Convert(err).Details()
+	if len(details) != 1 {
+		t.Fatalf("expected ErrorInfo detail, got %v", details)
+	}
+	if info, ok := details[0].(*errdetails.ErrorInfo); !ok || info.Reason != "UPSTREAM_SCHEMA_MISMATCH" {
+		t.Errorf("expected UPSTREAM_SCHEMA_MISMATCH, got %v", details)
+	}
+}
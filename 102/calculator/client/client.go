@@ -7,7 +7,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 	"log"
+	"time"
 )
 
 func main() {
@@ -19,6 +21,11 @@ func main() {
 
 	client := pb.NewCalculatorServiceClient(cc)
 	//doUnary(client)
+	//doArithmetic(client)
+	//doServerStreaming(client)
+	//doClientStreaming(client)
+	//doBiDiStreaming(client)
+	//doDecimal(client)
 	doErrorUnary(client)
 }
 
@@ -35,6 +42,122 @@ func doUnary(c pb.CalculatorServiceClient) {
 	fmt.Printf("Sum of %d & %d is = %d", req.FirstNumber, req.SecondNumber, res.SumResult)
 }
 
+func doArithmetic(c pb.CalculatorServiceClient) {
+	fmt.Println("starting to do Arithmetic Unary RPCs...")
+	req := &pb.ArithmeticRequest{
+		FirstNumber:  &pb.Number{Value: &pb.Number_IntValue{IntValue: 7}},
+		SecondNumber: &pb.Number{Value: &pb.Number_DoubleValue{DoubleValue: 2}},
+	}
+	res, err := c.Divide(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling Divide RPC: %v", err)
+	}
+	fmt.Printf("7 / 2 is = %v\n", res.GetResult().GetDoubleValue())
+
+	// error call, the result doesn't fit in an int64
+	req = &pb.ArithmeticRequest{
+		FirstNumber:  &pb.Number{Value: &pb.Number_IntValue{IntValue: 2}},
+		SecondNumber: &pb.Number{Value: &pb.Number_IntValue{IntValue: 64}},
+	}
+	if _, err := c.Power(context.Background(), req); status.Code(err) == codes.OutOfRange {
+		fmt.Printf("error message from server: %v\n", status.Convert(err).Message())
+	}
+}
+
+func doServerStreaming(c pb.CalculatorServiceClient) {
+	fmt.Println("starting to do a PrimeDecomposition Server Streaming RPC...")
+	req := &pb.PrimeDecompositionRequest{
+		Number: 12390392840,
+	}
+	stream, err := c.PrimeDecomposition(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling PrimeDecomposition RPC: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		fmt.Println(res.GetPrimeFactor())
+	}
+}
+
+func doClientStreaming(c pb.CalculatorServiceClient) {
+	fmt.Println("starting to do a ComputeStats Client Streaming RPC...")
+	stream, err := c.ComputeStats(context.Background())
+	if err != nil {
+		log.Fatalf("error while calling ComputeStats RPC: %v", err)
+	}
+	for _, number := range []float64{3, 5, 9, 54, 23} {
+		fmt.Printf("sending number: %v\n", number)
+		stream.Send(&pb.ComputeStatsRequest{
+			Number: number,
+		})
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalf("error while receiving response: %v", err)
+	}
+	fmt.Printf("mean: %v, standard deviation: %v, median: %v\n", res.GetMean(), res.GetStandardDeviation(), res.GetMedian())
+}
+
+func doBiDiStreaming(c pb.CalculatorServiceClient) {
+	fmt.Println("starting to do a RunningAggregate BiDi Streaming RPC...")
+	stream, err := c.RunningAggregate(context.Background())
+	if err != nil {
+		log.Fatalf("error while calling RunningAggregate RPC: %v", err)
+	}
+
+	waitc := make(chan struct{})
+	go func() {
+		for i, number := range []float64{1, 5, 3, 6, 2, 20} {
+			req := &pb.RunningAggregateRequest{Number: number}
+			if i == 0 {
+				req.Window = 3
+			}
+			fmt.Printf("sending number: %v\n", number)
+			stream.Send(req)
+			time.Sleep(500 * time.Millisecond)
+		}
+		stream.CloseSend()
+	}()
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Printf("error while receiving: %v", err)
+				break
+			}
+			fmt.Printf("max: %v, min: %v, moving average: %v\n", res.GetMax(), res.GetMin(), res.GetMovingAverage())
+		}
+		close(waitc)
+	}()
+	<-waitc
+}
+
+func doDecimal(c pb.CalculatorServiceClient) {
+	fmt.Println("starting to do a DecimalDivide Unary RPC...")
+	req := &pb.DecimalRequest{
+		FirstNumber:  "1000000.00",
+		SecondNumber: "3",
+		Context: &pb.DecimalContext{
+			Precision:    12,
+			RoundingMode: pb.RoundingMode_ROUND_HALF_UP,
+		},
+	}
+	res, err := c.DecimalDivide(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling DecimalDivide RPC: %v", err)
+	}
+	fmt.Printf("%s / %s is = %s\n", req.FirstNumber, req.SecondNumber, res.GetResult())
+}
+
 func doErrorUnary(c pb.CalculatorServiceClient) {
 	fmt.Println("starting  to do a SquareRoot Unary RPC...")
 
@@ -0,0 +1,68 @@
+package main
+
+import "calculator/pb"
+
+const (
+	// defaultWindow is the window of the moving average when the client sets none
+	defaultWindow = 10
+	// maxWindow bounds the numbers a RunningAggregate stream keeps
+	maxWindow = 10000
+)
+
+// runningAggregate keeps the max, the min and the moving average over
+// a window of the latest numbers of a stream
+type runningAggregate struct {
+	window   []float64 // ring buffer of the latest numbers
+	next     int
+	count    int64
+	sum      float64 // sum of the numbers in the window
+	min, max float64
+}
+
+func newRunningAggregate(window int) *runningAggregate {
+	return &runningAggregate{window: make([]float64, 0, window)}
+}
+
+// add adds a number to the aggregate and returns its new state
+func (a *runningAggregate) add(x float64) *pb.RunningAggregateResponse {
+	if a.count == 0 || x < a.min {
+		a.min = x
+	}
+	if a.count == 0 || x > a.max {
+		a.max = x
+	}
+	a.count++
+
+	if len(a.window) < cap(a.window) {
+		a.window = append(a.window, x)
+		a.sum += x
+	} else {
+		a.sum += x - a.window[a.next]
+		a.window[a.next] = x
+		a.next = (a.next + 1) % len(a.window)
+		if a.next == 0 {
+			// start over from the exact sum once per window, or the
+			// rounding errors of the running sum would pile up
+			a.sum = 0
+			for _, v := range a.window {
+				a.sum += v
+			}
+		}
+	}
+
+	return &pb.RunningAggregateResponse{
+		Max:           a.max,
+		Min:           a.min,
+		MovingAverage: a.sum / float64(len(a.window)),
+		Count:         a.count,
+	}
+}
+
+// aggregateChanged reports whether the max, the min or the moving average
+// of an aggregate differ from the previous ones
+func aggregateChanged(previous, current *pb.RunningAggregateResponse) bool {
+	return previous == nil ||
+		previous.Max != current.Max ||
+		previous.Min != current.Min ||
+		previous.MovingAverage != current.MovingAverage
+}
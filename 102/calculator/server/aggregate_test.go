@@ -0,0 +1,89 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"io"
+	"math"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunningAggregate(t *testing.T) {
+	c := newTestClient(t)
+	stream, err := c.RunningAggregate(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type state struct{ max, min, average float64 }
+	tests := []struct {
+		number float64
+		want   *state // nil when nothing changes
+	}{
+		{4, &state{4, 4, 4}},
+		{2, &state{4, 2, 3}},
+		{6, &state{6, 2, 4}}, // the window is full
+		{4, nil},             // 2 + 6 + 4 is still 12
+		{0, &state{6, 0, 10.0 / 3}},
+		{6, nil}, // 6 + 4 + 0 is 10 again
+		{-3, &state{6, -3, 1}},
+	}
+	for i, tt := range tests {
+		req := &pb.RunningAggregateRequest{Number: tt.number}
+		if i == 0 {
+			req.Window = 3
+		}
+		if err := stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+		if tt.want == nil {
+			continue
+		}
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Max != tt.want.max || res.Min != tt.want.min || res.MovingAverage != tt.want.average || res.Count != int64(i+1) {
+			t.Errorf("after %v expected %+v, got %v", tt.number, *tt.want, res)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := stream.Recv(); err != io.EOF {
+		t.Errorf("expected the end of the stream, got %v %v", res, err)
+	}
+}
+
+func TestRunningAggregateInvalid(t *testing.T) {
+	c := newTestClient(t)
+	for name, req := range map[string]*pb.RunningAggregateRequest{
+		"negative window": {Number: 1, Window: -1},
+		"large window":    {Number: 1, Window: maxWindow + 1},
+		"not finite":      {Number: math.Inf(1)},
+	} {
+		stream, err := c.RunningAggregate(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream.Send(req)
+		if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestRunningAggregateDefaultWindow(t *testing.T) {
+	aggregate := newRunningAggregate(defaultWindow)
+	var res *pb.RunningAggregateResponse
+	for i := 1; i <= 2*defaultWindow; i++ {
+		res = aggregate.add(float64(i))
+	}
+	// the average of 11 to 20
+	if res.MovingAverage != 15.5 || res.Min != 1 || res.Max != 20 {
+		t.Errorf("unexpected aggregate %v", res)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"calculator/pb"
+	"errors"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errUseDoubles tells the integer result of an operation isn't an integer,
+// so it has to be carried out in floating point
+var errUseDoubles = errors.New("result is not an integer")
+
+// operation is a binary operation on numbers, done on int64 when both
+// operands are integers and on float64 otherwise
+type operation struct {
+	symbol  string
+	ints    func(a, b int64) (int64, error)
+	doubles func(a, b float64) (float64, error)
+}
+
+var (
+	addition       = operation{"+", addInts, func(a, b float64) (float64, error) { return a + b, nil }}
+	subtraction    = operation{"-", subtractInts, func(a, b float64) (float64, error) { return a - b, nil }}
+	multiplication = operation{"*", multiplyInts, func(a, b float64) (float64, error) { return a * b, nil }}
+	division       = operation{"/", divideInts, divideDoubles}
+	modulo         = operation{"%", moduloInts, moduloDoubles}
+	power          = operation{"^", powerInts, powerDoubles}
+)
+
+// apply carries out the operation on the numbers of a request
+func (o operation) apply(req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	a, b := req.GetFirstNumber(), req.GetSecondNumber()
+	if a.GetValue() == nil || b.GetValue() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Both numbers are required")
+	}
+
+	x, xIsInt := a.GetValue().(*pb.Number_IntValue)
+	y, yIsInt := b.GetValue().(*pb.Number_IntValue)
+	if xIsInt && yIsInt {
+		result, err := o.ints(x.IntValue, y.IntValue)
+		if err == nil {
+			return &pb.ArithmeticResponse{Result: &pb.Number{Value: &pb.Number_IntValue{IntValue: result}}}, nil
+		}
+		if err != errUseDoubles {
+			return nil, err
+		}
+	}
+
+	first, second := toDouble(a), toDouble(b)
+	if !isFinite(first) || !isFinite(second) {
+		return nil, status.Errorf(codes.InvalidArgument, "Received a number that is not finite: %v %s %v", first, o.symbol, second)
+	}
+	result, err := o.doubles(first, second)
+	switch {
+	case err != nil:
+		return nil, err
+	case math.IsInf(result, 0):
+		return nil, status.Errorf(codes.OutOfRange, "Overflow: %v %s %v does not fit in a double", first, o.symbol, second)
+	case math.IsNaN(result):
+		return nil, status.Errorf(codes.InvalidArgument, "Undefined result: %v %s %v", first, o.symbol, second)
+	}
+	return &pb.ArithmeticResponse{Result: &pb.Number{Value: &pb.Number_DoubleValue{DoubleValue: result}}}, nil
+}
+
+func isFinite(x float64) bool {
+	return !math.IsInf(x, 0) && !math.IsNaN(x)
+}
+
+func toDouble(n *pb.Number) float64 {
+	if v, ok := n.GetValue().(*pb.Number_IntValue); ok {
+		return float64(v.IntValue)
+	}
+	return n.GetDoubleValue()
+}
+
+func overflow(a int64, symbol string, b int64) error {
+	return status.Errorf(codes.OutOfRange, "Overflow: %d %s %d does not fit in an int64", a, symbol, b)
+}
+
+func divisionByZero(a interface{}, symbol string) error {
+	return status.Errorf(codes.InvalidArgument, "Division by zero: %v %s 0", a, symbol)
+}
+
+func addInts(a, b int64) (int64, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, overflow(a, "+", b)
+	}
+	return c, nil
+}
+
+func subtractInts(a, b int64) (int64, error) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return 0, overflow(a, "-", b)
+	}
+	return c, nil
+}
+
+func multiplyInts(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, overflow(a, "*", b)
+	}
+	return c, nil
+}
+
+func divideInts(a, b int64) (int64, error) {
+	switch {
+	case b == 0:
+		return 0, divisionByZero(a, "/")
+	case a == math.MinInt64 && b == -1:
+		return 0, overflow(a, "/", b)
+	}
+	return a / b, nil
+}
+
+func divideDoubles(a, b float64) (float64, error) {
+	if b == 0 {
+		return 0, divisionByZero(a, "/")
+	}
+	return a / b, nil
+}
+
+func moduloInts(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, divisionByZero(a, "%")
+	}
+	return a % b, nil
+}
+
+func moduloDoubles(a, b float64) (float64, error) {
+	if b == 0 {
+		return 0, divisionByZero(a, "%")
+	}
+	return math.Mod(a, b), nil
+}
+
+// powerInts raises a to the power b by squaring, checking every product
+func powerInts(a, b int64) (int64, error) {
+	if b < 0 {
+		return 0, errUseDoubles
+	}
+	result, base := int64(1), a
+	for e := b; e > 0; e >>= 1 {
+		var err error
+		if e&1 == 1 {
+			if result, err = multiplyInts(result, base); err != nil {
+				return 0, overflow(a, "^", b)
+			}
+		}
+		if e > 1 {
+			if base, err = multiplyInts(base, base); err != nil {
+				return 0, overflow(a, "^", b)
+			}
+		}
+	}
+	return result, nil
+}
+
+func powerDoubles(a, b float64) (float64, error) {
+	if a == 0 && b < 0 {
+		return 0, divisionByZero(1, "/")
+	}
+	return math.Pow(a, b), nil
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"calculator/pb"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPrecision is the precision of decimal results when the client
+	// sets none, the one of IEEE 754 decimal128
+	defaultPrecision = 34
+	// maxPrecision bounds the precision of decimal results
+	maxPrecision = 1000
+	// maxDigits bounds the digits of the decimals received
+	maxDigits = 1000
+	// maxExponent bounds the exponent of the decimals received
+	maxExponent = 1000
+)
+
+var decimalPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+)
+
+// decimal is the number unscaled × 10^-scale
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// decimalOperation computes the exact result of an operation, or one
+// carrying enough digits to be rounded to precision
+type decimalOperation func(a, b decimal, precision int) (decimal, error)
+
+// applyDecimal carries out the operation on the decimals of a request
+func applyDecimal(req *pb.DecimalRequest, op decimalOperation) (*pb.DecimalResponse, error) {
+	precision, err := decimalPrecision(req.GetContext(), "context.precision")
+	if err != nil {
+		return nil, err
+	}
+	a, err := parseDecimal(req.GetFirstNumber())
+	if err != nil {
+		return nil, badDecimal("first_number", err)
+	}
+	b, err := parseDecimal(req.GetSecondNumber())
+	if err != nil {
+		return nil, badDecimal("second_number", err)
+	}
+	result, err := op(a, b, precision)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DecimalResponse{
+		Result: round(result, precision, req.GetContext().GetRoundingMode()).String(),
+	}, nil
+}
+
+// decimalPrecision returns the precision set in context, or the default one
+func decimalPrecision(context *pb.DecimalContext, field string) (int, error) {
+	precision := int(context.GetPrecision())
+	switch {
+	case precision == 0:
+		return defaultPrecision, nil
+	case precision < 0 || precision > maxPrecision:
+		return 0, badDecimal(field, fmt.Errorf("the precision %d is out of 1 to %d", precision, maxPrecision))
+	}
+	return precision, nil
+}
+
+// badDecimal reports an invalid field of a decimal request
+func badDecimal(field string, err error) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Received an invalid %s: %v", field, err))
+	st, _ = st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: err.Error(),
+		}},
+	})
+	return st.Err()
+}
+
+// parseDecimal parses a decimal like "-1234.5678" or "1.5e-8"
+func parseDecimal(s string) (decimal, error) {
+	m := decimalPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[2]+m[3] == "" {
+		return decimal{}, fmt.Errorf("%q is not a decimal", s)
+	}
+	sign, integer, fraction, exponent := m[1], m[2], m[3], m[4]
+	if len(integer)+len(fraction) > maxDigits {
+		return decimal{}, fmt.Errorf("the decimal has more than %d digits", maxDigits)
+	}
+	exp := 0
+	if exponent != "" {
+		var err error
+		exp, err = strconv.Atoi(exponent)
+		if err != nil || exp > maxExponent || exp < -maxExponent {
+			return decimal{}, fmt.Errorf("the exponent of %q is out of -%d to %d", s, maxExponent, maxExponent)
+		}
+	}
+	unscaled, _ := new(big.Int).SetString(sign+integer+fraction, 10)
+	return decimal{unscaled: unscaled, scale: len(fraction) - exp}, nil
+}
+
+// String renders the decimal in plain notation, without trailing zeros
+func (d decimal) String() string {
+	d = d.normalize()
+	if d.scale <= 0 {
+		return new(big.Int).Mul(d.unscaled, pow10(-d.scale)).String()
+	}
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	point := len(digits) - d.scale
+	s := digits[:point] + "." + digits[point:]
+	if d.unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// normalize removes the trailing zeros of the fraction
+func (d decimal) normalize() decimal {
+	if d.unscaled.Sign() == 0 {
+		return decimal{unscaled: new(big.Int), scale: 0}
+	}
+	unscaled := new(big.Int).Set(d.unscaled)
+	q, r := new(big.Int), new(big.Int)
+	for d.scale > 0 {
+		q.QuoRem(unscaled, bigTen, r)
+		if r.Sign() != 0 {
+			break
+		}
+		unscaled.Set(q)
+		d.scale--
+	}
+	return decimal{unscaled: unscaled, scale: d.scale}
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// numDigits returns the number of decimal digits of |x|, 1 for 0
+func numDigits(x *big.Int) int {
+	return len(new(big.Int).Abs(x).String())
+}
+
+// align returns the unscaled values of a and b at the same scale
+func align(a, b decimal) (*big.Int, *big.Int, int) {
+	x, y := new(big.Int).Set(a.unscaled), new(big.Int).Set(b.unscaled)
+	switch {
+	case a.scale < b.scale:
+		x.Mul(x, pow10(b.scale-a.scale))
+		return x, y, b.scale
+	case a.scale > b.scale:
+		y.Mul(y, pow10(a.scale-b.scale))
+	}
+	return x, y, a.scale
+}
+
+func addDecimals(a, b decimal, _ int) (decimal, error) {
+	x, y, scale := align(a, b)
+	return decimal{unscaled: x.Add(x, y), scale: scale}, nil
+}
+
+func subtractDecimals(a, b decimal, _ int) (decimal, error) {
+	x, y, scale := align(a, b)
+	return decimal{unscaled: x.Sub(x, y), scale: scale}, nil
+}
+
+func multiplyDecimals(a, b decimal, _ int) (decimal, error) {
+	return decimal{unscaled: new(big.Int).Mul(a.unscaled, b.unscaled), scale: a.scale + b.scale}, nil
+}
+
+// divideDecimals divides a by b != 0 with enough digits to be rounded to
+// precision: two more than needed, and a last one telling whether the
+// division is exact, so that the rounding sees the same ties as on the
+// exact quotient
+func divideDecimals(a, b decimal, precision int) (decimal, error) {
+	if b.unscaled.Sign() == 0 {
+		return decimal{}, status.Errorf(codes.InvalidArgument, "Division by zero: %v / 0", a)
+	}
+	shift := precision + 3 + numDigits(b.unscaled) - numDigits(a.unscaled)
+	if shift < 0 {
+		shift = 0
+	}
+	x := new(big.Int).Mul(a.unscaled, pow10(shift))
+	q, r := new(big.Int).QuoRem(x, b.unscaled, new(big.Int))
+	return sticky(decimal{unscaled: q, scale: a.scale - b.scale + shift}, r.Sign() != 0, x.Sign()*b.unscaled.Sign()), nil
+}
+
+// sqrtDecimal computes the square root of a >= 0 with enough digits to be
+// rounded to precision, like divideDecimals
+func sqrtDecimal(a decimal, precision int) decimal {
+	// the unscaled value gets an even scale and at least 2 × (precision + 2)
+	// digits, so that its square root has precision + 2
+	shift := 2*(precision+2) - numDigits(a.unscaled)
+	if shift < 0 {
+		shift = 0
+	}
+	if (a.scale+shift)%2 != 0 {
+		shift++
+	}
+	x := new(big.Int).Mul(a.unscaled, pow10(shift))
+	root := new(big.Int).Sqrt(x)
+	exact := new(big.Int).Mul(root, root).Cmp(x) == 0
+	return sticky(decimal{unscaled: root, scale: (a.scale + shift) / 2}, !exact, 1)
+}
+
+// sticky appends to a truncated result a last digit, 1 when digits were
+// dropped and 0 otherwise, sign being the sign of the exact result
+func sticky(d decimal, inexact bool, sign int) decimal {
+	unscaled := new(big.Int).Mul(d.unscaled, bigTen)
+	if inexact {
+		if sign < 0 {
+			unscaled.Sub(unscaled, bigOne)
+		} else {
+			unscaled.Add(unscaled, bigOne)
+		}
+	}
+	return decimal{unscaled: unscaled, scale: d.scale + 1}
+}
+
+// round rounds d to precision significant digits
+func round(d decimal, precision int, mode pb.RoundingMode) decimal {
+	drop := numDigits(d.unscaled) - precision
+	if drop <= 0 {
+		return d
+	}
+	divisor := pow10(drop)
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(d.unscaled), divisor, new(big.Int))
+	negative := d.unscaled.Sign() < 0
+
+	// half compares the dropped digits with half a unit of the last digit kept
+	half := new(big.Int).Lsh(r, 1).Cmp(divisor)
+	var up bool
+	switch mode {
+	case pb.RoundingMode_ROUND_HALF_UP:
+		up = half >= 0
+	case pb.RoundingMode_ROUND_HALF_DOWN:
+		up = half > 0
+	case pb.RoundingMode_ROUND_UP:
+		up = r.Sign() != 0
+	case pb.RoundingMode_ROUND_DOWN:
+		up = false
+	case pb.RoundingMode_ROUND_CEILING:
+		up = r.Sign() != 0 && !negative
+	case pb.RoundingMode_ROUND_FLOOR:
+		up = r.Sign() != 0 && negative
+	default:
+		up = half > 0 || (half == 0 && q.Bit(0) == 1)
+	}
+	if up {
+		q.Add(q, bigOne)
+	}
+	if negative {
+		q.Neg(q)
+	}
+	return decimal{unscaled: q, scale: d.scale - drop}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecimalArithmetic(t *testing.T) {
+	c := newTestClient(t)
+	type rpc func(context.Context, *pb.DecimalRequest, ...grpc.CallOption) (*pb.DecimalResponse, error)
+	tests := []struct {
+		name      string
+		call      rpc
+		a, b      string
+		precision int32
+		mode      pb.RoundingMode
+		want      string
+	}{
+		{"add", c.DecimalAdd, "0.1", "0.2", 0, 0, "0.3"},
+		{"add exponents", c.DecimalAdd, "1.5e-8", "-.5E1", 0, 0, "-4.999999985"},
+		{"add trailing zeros", c.DecimalAdd, "1.10", "2.20", 0, 0, "3.3"},
+		{"subtract", c.DecimalSubtract, "100", "0.01", 0, 0, "99.99"},
+		{"subtract to zero", c.DecimalSubtract, "-2.50", "-2.5", 0, 0, "0"},
+		{"multiply", c.DecimalMultiply, "123456789012345678901234567890", "987654321098765432109876543210", 100, 0,
+			"121932631137021795226185032733622923332237463801111263526900"},
+		{"multiply rounded", c.DecimalMultiply, "123456789012345678901234567890", "987654321098765432109876543210", 0, 0,
+			"121932631137021795226185032733622900000000000000000000000000"},
+		{"precision", c.DecimalAdd, "12345", "0", 2, 0, "12000"},
+		{"divide", c.DecimalDivide, "1", "3", 5, 0, "0.33333"},
+		{"divide half even", c.DecimalDivide, "2", "3", 5, 0, "0.66667"},
+		{"divide exact", c.DecimalDivide, "1", "8", 0, 0, "0.125"},
+		{"divide down", c.DecimalDivide, "2", "3", 5, pb.RoundingMode_ROUND_DOWN, "0.66666"},
+		{"divide floor", c.DecimalDivide, "-1", "3", 2, pb.RoundingMode_ROUND_FLOOR, "-0.34"},
+		{"divide ceiling", c.DecimalDivide, "-1", "3", 2, pb.RoundingMode_ROUND_CEILING, "-0.33"},
+		{"divide up", c.DecimalDivide, "-1", "3", 2, pb.RoundingMode_ROUND_UP, "-0.34"},
+		{"tie half even", c.DecimalDivide, "5", "2", 1, 0, "2"},
+		{"tie half up", c.DecimalDivide, "5", "2", 1, pb.RoundingMode_ROUND_HALF_UP, "3"},
+		{"tie half down", c.DecimalDivide, "-5", "2", 1, pb.RoundingMode_ROUND_HALF_DOWN, "-2"},
+		{"above tie half down", c.DecimalDivide, "2.5000001", "1", 1, pb.RoundingMode_ROUND_HALF_DOWN, "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.call(context.Background(), &pb.DecimalRequest{
+				FirstNumber:  tt.a,
+				SecondNumber: tt.b,
+				Context:      &pb.DecimalContext{Precision: tt.precision, RoundingMode: tt.mode},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Result != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, res.Result)
+			}
+		})
+	}
+}
+
+func TestDecimalSquareRoot(t *testing.T) {
+	c := newTestClient(t)
+	tests := []struct {
+		number    string
+		precision int32
+		want      string
+	}{
+		{"2", 50, "1.4142135623730950488016887242096980785696718753769"},
+		{"1e-8", 0, "0.0001"},
+		{"152.2756", 0, "12.34"},
+		{"0", 0, "0"},
+		{"1e3", 5, "31.623"},
+	}
+	for _, tt := range tests {
+		res, err := c.DecimalSquareRoot(context.Background(), &pb.DecimalSquareRootRequest{
+			Number:  tt.number,
+			Context: &pb.DecimalContext{Precision: tt.precision},
+		})
+		if err != nil || res.Result != tt.want {
+			t.Errorf("sqrt(%s) = %v %v, want %s", tt.number, res, err, tt.want)
+		}
+	}
+
+	_, err := c.DecimalSquareRoot(context.Background(), &pb.DecimalSquareRootRequest{Number: "-4"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestDecimalErrors(t *testing.T) {
+	c := newTestClient(t)
+	tests := []struct {
+		name  string
+		req   *pb.DecimalRequest
+		field string
+	}{
+		{"not a decimal", &pb.DecimalRequest{FirstNumber: "1,5", SecondNumber: "1"}, "first_number"},
+		{"empty", &pb.DecimalRequest{FirstNumber: "1"}, "second_number"},
+		{"too many digits", &pb.DecimalRequest{FirstNumber: "1", SecondNumber: strings.Repeat("9", maxDigits+1)}, "second_number"},
+		{"exponent", &pb.DecimalRequest{FirstNumber: "1e99999", SecondNumber: "1"}, "first_number"},
+		{"precision", &pb.DecimalRequest{FirstNumber: "1", SecondNumber: "1", Context: &pb.DecimalContext{Precision: maxPrecision + 1}}, "context.precision"},
+		{"division by zero", &pb.DecimalRequest{FirstNumber: "1", SecondNumber: "0.00"}, ""},
+	}
+	for _, tt := range tests {
+		_, err := c.DecimalDivide(context.Background(), tt.req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", tt.name, err)
+			continue
+		}
+		var field string
+		for _, detail := range status.Convert(err).Details() {
+			if br, ok := detail.(*errdetails.BadRequest); ok && len(br.FieldViolations) > 0 {
+				field = br.FieldViolations[0].Field
+			}
+		}
+		if field != tt.field {
+			t.Errorf("%s: expected a violation of %q, got %q", tt.name, tt.field, field)
+		}
+	}
+}
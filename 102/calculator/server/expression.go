@@ -0,0 +1,428 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// maxExpressionLength bounds the size of the expressions accepted by Evaluate
+	maxExpressionLength = 4096
+	// maxDepth bounds the nesting of an expression, keeping the parser stack small
+	maxDepth = 100
+)
+
+// exprError is an error found at a byte offset of an expression
+type exprError struct {
+	code   codes.Code
+	offset int
+	msg    string
+}
+
+func (e *exprError) Error() string {
+	return e.msg
+}
+
+// position turns the offset of the error into a position in characters, counted from 1
+func (e *exprError) position(expression string) int {
+	return runePosition(expression, e.offset)
+}
+
+// runePosition turns a byte offset of expression into a position in
+// characters, counted from 1
+func runePosition(expression string, offset int) int {
+	if offset > len(expression) {
+		offset = len(expression)
+	}
+	return utf8.RuneCountInString(expression[:offset]) + 1
+}
+
+func errorAt(offset int, format string, a ...interface{}) error {
+	return &exprError{code: codes.InvalidArgument, offset: offset, msg: fmt.Sprintf(format, a...)}
+}
+
+// node is a node of the syntax tree of an expression
+type node interface {
+	eval(vars map[string]float64) (float64, error)
+}
+
+type (
+	number struct {
+		value float64
+	}
+	variable struct {
+		offset int
+		name   string
+	}
+	unary struct {
+		op      byte
+		operand node
+	}
+	binary struct {
+		offset      int
+		op          byte
+		left, right node
+	}
+	call struct {
+		offset int
+		name   string
+		args   []node
+	}
+)
+
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+type function struct {
+	arity int
+	apply func(args []float64) (float64, error)
+}
+
+var functions = map[string]function{
+	"sqrt": {1, func(x []float64) (float64, error) {
+		if x[0] < 0 {
+			return 0, fmt.Errorf("square root of a negative number: %v", x[0])
+		}
+		return math.Sqrt(x[0]), nil
+	}},
+	"ln": {1, func(x []float64) (float64, error) {
+		if x[0] <= 0 {
+			return 0, fmt.Errorf("logarithm of a number that is not positive: %v", x[0])
+		}
+		return math.Log(x[0]), nil
+	}},
+	"abs": {1, func(x []float64) (float64, error) { return math.Abs(x[0]), nil }},
+	"exp": {1, func(x []float64) (float64, error) { return math.Exp(x[0]), nil }},
+	"sin": {1, func(x []float64) (float64, error) { return math.Sin(x[0]), nil }},
+	"cos": {1, func(x []float64) (float64, error) { return math.Cos(x[0]), nil }},
+	"tan": {1, func(x []float64) (float64, error) { return math.Tan(x[0]), nil }},
+	"min": {2, func(x []float64) (float64, error) { return math.Min(x[0], x[1]), nil }},
+	"max": {2, func(x []float64) (float64, error) { return math.Max(x[0], x[1]), nil }},
+}
+
+func (n *number) eval(map[string]float64) (float64, error) {
+	return n.value, nil
+}
+
+func (v *variable) eval(vars map[string]float64) (float64, error) {
+	if value, ok := vars[v.name]; ok {
+		return value, nil
+	}
+	if value, ok := constants[v.name]; ok {
+		return value, nil
+	}
+	return 0, errorAt(v.offset, "unknown variable %q", v.name)
+}
+
+func (u *unary) eval(vars map[string]float64) (float64, error) {
+	x, err := u.operand.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	if u.op == '-' {
+		return -x, nil
+	}
+	return x, nil
+}
+
+func (b *binary) eval(vars map[string]float64) (float64, error) {
+	x, err := b.left.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	y, err := b.right.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	var result float64
+	switch b.op {
+	case '+':
+		result = x + y
+	case '-':
+		result = x - y
+	case '*':
+		result = x * y
+	case '/', '%':
+		if y == 0 {
+			return 0, errorAt(b.offset, "division by zero")
+		}
+		if b.op == '/' {
+			result = x / y
+		} else {
+			result = math.Mod(x, y)
+		}
+	case '^':
+		if x == 0 && y < 0 {
+			return 0, errorAt(b.offset, "division by zero")
+		}
+		result = math.Pow(x, y)
+	}
+	return checkResult(b.offset, result)
+}
+
+func (c *call) eval(vars map[string]float64) (float64, error) {
+	args := make([]float64, len(c.args))
+	for i, arg := range c.args {
+		x, err := arg.eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = x
+	}
+	result, err := functions[c.name].apply(args)
+	if err != nil {
+		return 0, errorAt(c.offset, "%v", err)
+	}
+	return checkResult(c.offset, result)
+}
+
+// checkResult rejects the results that aren't finite numbers
+func checkResult(offset int, result float64) (float64, error) {
+	switch {
+	case math.IsInf(result, 0):
+		return 0, &exprError{code: codes.OutOfRange, offset: offset, msg: "result does not fit in a double"}
+	case math.IsNaN(result):
+		return 0, errorAt(offset, "result is not a number")
+	}
+	return result, nil
+}
+
+// token is a lexical unit of an expression: a number, an identifier,
+// an operator or a parenthesis, or the end of the expression
+type token struct {
+	kind   byte // 'n' number, 'i' identifier, 0 end, or the operator itself
+	offset int
+	text   string
+}
+
+func (t token) String() string {
+	if t.kind == 0 {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+// lex splits an expression into tokens
+func lex(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		r, size := utf8.DecodeRuneInString(expression[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r >= '0' && r <= '9' || r == '.':
+			end := scanNumber(expression, i)
+			tokens = append(tokens, token{kind: 'n', offset: i, text: expression[i:end]})
+			i = end
+		case r == '_' || unicode.IsLetter(r):
+			end := i
+			for end < len(expression) {
+				r, size := utf8.DecodeRuneInString(expression[end:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				end += size
+			}
+			tokens = append(tokens, token{kind: 'i', offset: i, text: expression[i:end]})
+			i = end
+		case strings.ContainsRune("+-*/%^(),", r):
+			tokens = append(tokens, token{kind: byte(r), offset: i, text: string(r)})
+			i++
+		default:
+			return nil, errorAt(i, "unexpected character %q", r)
+		}
+	}
+	return append(tokens, token{offset: len(expression)}), nil
+}
+
+// scanNumber returns the end of the number starting at start, like 12, 1.5, .5 or 2e-3
+func scanNumber(s string, start int) int {
+	digits := func(i int) int {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	end := digits(start)
+	if end < len(s) && s[end] == '.' {
+		end = digits(end + 1)
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end + 1
+		if exp < len(s) && (s[exp] == '+' || s[exp] == '-') {
+			exp++
+		}
+		if after := digits(exp); after > exp {
+			end = after
+		}
+	}
+	return end
+}
+
+// parser builds the syntax tree of an expression by recursive descent,
+// following the grammar
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/" | "%") unary }
+//	unary   = ("+" | "-") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | identifier [ "(" expr { "," expr } ")" ] | "(" expr ")"
+type parser struct {
+	expression string
+	tokens     []token
+	next       int
+	depth      int
+}
+
+// parse parses an expression into its syntax tree
+func parse(expression string) (node, error) {
+	if len(expression) > maxExpressionLength {
+		return nil, errorAt(maxExpressionLength, "expression is longer than %d bytes", maxExpressionLength)
+	}
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{expression: expression, tokens: tokens}
+	tree, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != 0 {
+		return nil, errorAt(t.offset, "unexpected %v", t)
+	}
+	return tree, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	t := p.tokens[p.next]
+	if t.kind != 0 {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) expr() (node, error) {
+	left, err := p.term()
+	for err == nil && (p.peek().kind == '+' || p.peek().kind == '-') {
+		op := p.take()
+		var right node
+		if right, err = p.term(); err == nil {
+			left = &binary{offset: op.offset, op: op.kind, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (p *parser) term() (node, error) {
+	left, err := p.unary()
+	for err == nil && (p.peek().kind == '*' || p.peek().kind == '/' || p.peek().kind == '%') {
+		op := p.take()
+		var right node
+		if right, err = p.unary(); err == nil {
+			left = &binary{offset: op.offset, op: op.kind, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+// unary is where every nesting of the grammar goes through, so it
+// also keeps the depth in check
+func (p *parser) unary() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return nil, errorAt(p.peek().offset, "expression is nested more than %d levels deep", maxDepth)
+	}
+	if t := p.peek(); t.kind == '+' || t.kind == '-' {
+		p.take()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{op: t.kind, operand: operand}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (node, error) {
+	base, err := p.primary()
+	if err != nil || p.peek().kind != '^' {
+		return base, err
+	}
+	op := p.take()
+	// the exponent is parsed as a unary, making ^ right associative
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return &binary{offset: op.offset, op: '^', left: base, right: exponent}, nil
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.take()
+	switch t.kind {
+	case 'n':
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, errorAt(t.offset, "invalid number %v", t)
+		}
+		return &number{value: value}, nil
+	case 'i':
+		if p.peek().kind != '(' {
+			return &variable{offset: t.offset, name: t.text}, nil
+		}
+		return p.call(t)
+	case '(':
+		inner, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.take(); closing.kind != ')' {
+			return nil, errorAt(closing.offset, "expected \")\" to close the parenthesis at %d, got %v", runePosition(p.expression, t.offset), closing)
+		}
+		return inner, nil
+	default:
+		return nil, errorAt(t.offset, "unexpected %v", t)
+	}
+}
+
+// call parses the arguments of a call to the function named by name
+func (p *parser) call(name token) (node, error) {
+	f, ok := functions[name.text]
+	if !ok {
+		return nil, errorAt(name.offset, "unknown function %q", name.text)
+	}
+	p.take()
+	var args []node
+	for {
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		t := p.take()
+		if t.kind == ')' {
+			break
+		}
+		if t.kind != ',' {
+			return nil, errorAt(t.offset, "expected \",\" or \")\" in the arguments of %s, got %v", name.text, t)
+		}
+	}
+	if len(args) != f.arity {
+		return nil, errorAt(name.offset, "%s takes %d argument(s), got %d", name.text, f.arity, len(args))
+	}
+	return &call{offset: name.offset, name: name.text, args: args}, nil
+}
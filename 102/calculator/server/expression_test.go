@@ -0,0 +1,129 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEvaluate(t *testing.T) {
+	vars := map[string]float64{"x": 3, "rate_2": 0.5}
+	tests := []struct {
+		expression string
+		want       float64
+	}{
+		{"(3+4)*sqrt(16)/2", 14},
+		{"1 + 2 * 3", 7},
+		{"10 - 4 - 3", 3},
+		{"2 ^ 3 ^ 2", 512},
+		{"-2 ^ 2", -4},
+		{"2 ^ -1", 0.5},
+		{"7 % 4", 3},
+		{"x * rate_2 + .5", 2},
+		{"1.5e2 + 2E-1", 150.2},
+		{"max(x, 10) - min(-x, abs(-1))", 13},
+		{"ln(e) + cos(0) + exp(0)", 3},
+		{"--x", 3},
+		{"pi", math.Pi},
+	}
+	for _, tt := range tests {
+		tree, err := parse(tt.expression)
+		if err != nil {
+			t.Errorf("parse(%q): %v", tt.expression, err)
+			continue
+		}
+		got, err := tree.eval(vars)
+		if err != nil || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%q = %v %v, want %v", tt.expression, got, err, tt.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		code       codes.Code
+		position   int
+		msg        string
+	}{
+		{"(3+4", codes.InvalidArgument, 5, "expected \")\""},
+		{"é * (3+4", codes.InvalidArgument, 9, "parenthesis at 5"},
+		{"3 + * 4", codes.InvalidArgument, 5, "unexpected \"*\""},
+		{"3 4", codes.InvalidArgument, 3, "unexpected \"4\""},
+		{"", codes.InvalidArgument, 1, "unexpected end of expression"},
+		{"2 $ 3", codes.InvalidArgument, 3, "unexpected character"},
+		{"é + y", codes.InvalidArgument, 5, "unknown variable \"y\""},
+		{"foo(1)", codes.InvalidArgument, 1, "unknown function"},
+		{"max(1)", codes.InvalidArgument, 1, "max takes 2"},
+		{"1 / (x - 3)", codes.InvalidArgument, 3, "division by zero"},
+		{"1 + sqrt(-x)", codes.InvalidArgument, 5, "square root of a negative number"},
+		{"10 ^ 400", codes.OutOfRange, 4, "does not fit"},
+		{strings.Repeat("(", maxDepth+1) + "1" + strings.Repeat(")", maxDepth+1), codes.InvalidArgument, maxDepth + 1, "nested"},
+	}
+	for _, tt := range tests {
+		tree, err := parse(tt.expression)
+		if err == nil {
+			_, err = tree.eval(map[string]float64{"x": 3, "é": 1})
+		}
+		e, ok := err.(*exprError)
+		if !ok {
+			t.Errorf("%q: expected an error, got %v", tt.expression, err)
+			continue
+		}
+		if e.code != tt.code || e.position(tt.expression) != tt.position || !strings.Contains(e.msg, tt.msg) {
+			t.Errorf("%q: got %v %q at %d, want %v %q at %d", tt.expression, e.code, e.msg, e.position(tt.expression), tt.code, tt.msg, tt.position)
+		}
+	}
+}
+
+func TestEvaluateRPC(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Evaluate(context.Background(), &pb.EvaluateRequest{
+		Expression: "(3 + x) * sqrt(16) / 2",
+		Variables:  map[string]float64{"x": 4},
+	})
+	if err != nil || res.Result != 14 {
+		t.Fatalf("expected 14, got %v %v", res, err)
+	}
+
+	_, err = c.Evaluate(context.Background(), &pb.EvaluateRequest{Expression: "(3 + 4))"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range status.Convert(err).Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			violations = br.FieldViolations
+		}
+	}
+	if len(violations) != 1 || violations[0].Field != "expression" || !strings.HasPrefix(violations[0].Description, "position 8:") {
+		t.Errorf("expected the position in a BadRequest, got %v", status.Convert(err).Details())
+	}
+
+	_, err = c.Evaluate(context.Background(), &pb.EvaluateRequest{Expression: "10^400"})
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("expected OutOfRange, got %v", err)
+	}
+
+	for _, value := range []float64{math.Inf(1), math.NaN()} {
+		_, err = c.Evaluate(context.Background(), &pb.EvaluateRequest{Expression: "x", Variables: map[string]float64{"x": value}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("x = %v: expected InvalidArgument, got %v", value, err)
+		}
+		violations = nil
+		for _, detail := range status.Convert(err).Details() {
+			if br, ok := detail.(*errdetails.BadRequest); ok {
+				violations = br.FieldViolations
+			}
+		}
+		if len(violations) != 1 || violations[0].Field != "variables[x]" {
+			t.Errorf("x = %v: expected a violation on variables[x], got %v", value, status.Convert(err).Details())
+		}
+	}
+}
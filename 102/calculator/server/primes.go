@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+)
+
+// cancelCheckInterval is how many trial divisions primeFactors does
+// between two checks of its context
+const cancelCheckInterval = 1 << 16
+
+// primeFactors calls emit with every prime factor of n >= 2 as soon as it
+// finds it, in increasing order. It tries 2, 3 and then the numbers 6k±1,
+// stopping when the context is done.
+func primeFactors(ctx context.Context, n int64, emit func(int64) error) error {
+	for _, p := range []int64{2, 3} {
+		for n%p == 0 {
+			if err := emit(p); err != nil {
+				return err
+			}
+			n /= p
+		}
+	}
+
+	steps := 0
+	// k <= n/k rather than k*k <= n, which overflows near the int64 limit
+	for k := int64(5); k <= n/k; k += 6 {
+		if steps++; steps%cancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		for _, p := range []int64{k, k + 2} {
+			for n%p == 0 {
+				if err := emit(p); err != nil {
+					return err
+				}
+				n /= p
+			}
+		}
+	}
+	if n > 1 {
+		return emit(n)
+	}
+	return nil
+}
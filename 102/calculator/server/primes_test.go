@@ -0,0 +1,105 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// largestPrime is the largest prime fitting in an int64
+const largestPrime = 9223372036854775783
+
+func TestPrimeFactors(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want []int64
+	}{
+		{2, []int64{2}},
+		{120, []int64{2, 2, 2, 3, 5}},
+		{210, []int64{2, 3, 5, 7}},
+		{1 << 62, nil},
+		{999999999989 * 3, []int64{3, 999999999989}},
+		{1000003 * 999999999989, []int64{1000003, 999999999989}},
+	}
+	tests[3].want = make([]int64, 62)
+	for i := range tests[3].want {
+		tests[3].want[i] = 2
+	}
+	for _, tt := range tests {
+		var got []int64
+		err := primeFactors(context.Background(), tt.n, func(p int64) error {
+			got = append(got, p)
+			return nil
+		})
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("primeFactors(%d) = %v %v, want %v", tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestPrimeDecomposition(t *testing.T) {
+	c := newTestClient(t)
+	stream, err := c.PrimeDecomposition(context.Background(), &pb.PrimeDecompositionRequest{Number: 120})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var factors []int64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		factors = append(factors, res.PrimeFactor)
+	}
+	if !reflect.DeepEqual(factors, []int64{2, 2, 2, 3, 5}) {
+		t.Errorf("unexpected factors %v", factors)
+	}
+
+	for _, n := range []int64{1, 0, -12} {
+		stream, err := c.PrimeDecomposition(context.Background(), &pb.PrimeDecompositionRequest{Number: n})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%d: expected InvalidArgument, got %v", n, err)
+		}
+	}
+}
+
+func TestPrimeDecompositionCancel(t *testing.T) {
+	// trial division of the largest prime takes seconds, the deadline stops it
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := primeFactors(ctx, largestPrime, func(int64) error { return nil })
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected the deadline to stop the decomposition, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the decomposition stopped %v after its deadline", elapsed)
+	}
+
+	c := newTestClient(t)
+	ctx, cancel = context.WithCancel(context.Background())
+	// 2^61 - 1 is a prime too
+	stream, err := c.PrimeDecomposition(ctx, &pb.PrimeDecompositionRequest{Number: 2 * (1<<61 - 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := stream.Recv(); err != nil || res.PrimeFactor != 2 {
+		t.Fatalf("expected 2 first, got %v %v", res, err)
+	}
+	cancel()
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Errorf("expected Canceled, got %v", err)
+	}
+}
@@ -3,27 +3,68 @@ package main
 import (
 	"calculator/pb"
 	"context"
+	"errors"
 	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"io"
 	"log"
 	"math"
 	"net"
+	"sort"
 )
 
 type server struct{}
 
 func (*server) Sum(ctx context.Context, req *pb.SumRequest) (*pb.SumResponse, error) {
 	fmt.Println("invoked sum function")
+	sum := int64(req.FirstNumber) + int64(req.SecondNumber)
+	if sum > math.MaxInt32 || sum < math.MinInt32 {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			fmt.Sprintf("Overflow: %d + %d does not fit in an int32", req.FirstNumber, req.SecondNumber),
+		)
+	}
 	res := &pb.SumResponse{
-		SumResult: req.FirstNumber + req.SecondNumber,
+		SumResult: int32(sum),
 	}
 
 	return res, nil
 }
 
+func (*server) Add(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Add function")
+	return addition.apply(req)
+}
+
+func (*server) Subtract(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Subtract function")
+	return subtraction.apply(req)
+}
+
+func (*server) Multiply(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Multiply function")
+	return multiplication.apply(req)
+}
+
+func (*server) Divide(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Divide function")
+	return division.apply(req)
+}
+
+func (*server) Modulo(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Modulo function")
+	return modulo.apply(req)
+}
+
+func (*server) Power(ctx context.Context, req *pb.ArithmeticRequest) (*pb.ArithmeticResponse, error) {
+	fmt.Println("invoked Power function")
+	return power.apply(req)
+}
+
 func (*server) SquareRoot(ctx context.Context, req *pb.SquareRootRequest) (*pb.SquareRootResponse, error) {
 	fmt.Println("invoked SquareRoot function")
 	number := req.GetNumber()
@@ -40,6 +81,196 @@ func (*server) SquareRoot(ctx context.Context, req *pb.SquareRootRequest) (*pb.S
 
 }
 
+func (*server) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+	fmt.Println("invoked Evaluate function")
+	if err := checkVariables(req.GetVariables()); err != nil {
+		return nil, err
+	}
+	expression := req.GetExpression()
+	tree, err := parse(expression)
+	if err == nil {
+		var result float64
+		if result, err = tree.eval(req.GetVariables()); err == nil {
+			return &pb.EvaluateResponse{Result: result}, nil
+		}
+	}
+
+	var e *exprError
+	if !errors.As(err, &e) {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Could not evaluate the expression: %v", err))
+	}
+	position := e.position(expression)
+	st := status.New(e.code, fmt.Sprintf("Invalid expression: %v at position %d", e.msg, position))
+	if e.code == codes.InvalidArgument {
+		st, _ = st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "expression",
+				Description: fmt.Sprintf("position %d: %v", position, e.msg),
+			}},
+		})
+	}
+	return nil, st.Err()
+}
+
+// checkVariables rejects the variables that aren't finite numbers
+func checkVariables(variables map[string]float64) error {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if value := variables[name]; !isFinite(value) {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Received a variable that is not finite: %s = %v", name, value))
+			st, _ = st.WithDetails(&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+					Field:       fmt.Sprintf("variables[%s]", name),
+					Description: fmt.Sprintf("%v is not a finite number", value),
+				}},
+			})
+			return st.Err()
+		}
+	}
+	return nil
+}
+
+func (*server) PrimeDecomposition(req *pb.PrimeDecompositionRequest, stream pb.CalculatorService_PrimeDecompositionServer) error {
+	fmt.Println("invoked PrimeDecomposition function")
+	number := req.GetNumber()
+	if number < 2 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a number below 2: %v", number),
+		)
+	}
+
+	ctx := stream.Context()
+	err := primeFactors(ctx, number, func(factor int64) error {
+		return stream.Send(&pb.PrimeDecompositionResponse{PrimeFactor: factor})
+	})
+	if err == ctx.Err() && err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return err
+}
+
+func (*server) ComputeStats(stream pb.CalculatorService_ComputeStatsServer) error {
+	fmt.Println("invoked ComputeStats function")
+	stats := &runningStats{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		number := req.GetNumber()
+		if !isFinite(number) {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Received a number that is not finite: %v", number),
+			)
+		}
+		stats.add(number)
+	}
+
+	switch {
+	case stats.count == 0:
+		return status.Errorf(codes.InvalidArgument, "Received no number")
+	case math.IsInf(stats.sum, 0) || math.IsInf(stats.m2, 0):
+		return status.Errorf(codes.OutOfRange, "Overflow: the sum of the numbers does not fit in a double")
+	}
+	return stream.SendAndClose(stats.response())
+}
+
+func (*server) RunningAggregate(stream pb.CalculatorService_RunningAggregateServer) error {
+	fmt.Println("invoked RunningAggregate function")
+	var aggregate *runningAggregate
+	var last *pb.RunningAggregateResponse
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if aggregate == nil {
+			window := int(req.GetWindow())
+			if window < 0 || window > maxWindow {
+				return status.Errorf(
+					codes.InvalidArgument,
+					fmt.Sprintf("Received a window out of 0 to %d: %v", maxWindow, window),
+				)
+			}
+			if window == 0 {
+				window = defaultWindow
+			}
+			aggregate = newRunningAggregate(window)
+		}
+		number := req.GetNumber()
+		if !isFinite(number) {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Received a number that is not finite: %v", number),
+			)
+		}
+
+		res := aggregate.add(number)
+		if !aggregateChanged(last, res) {
+			continue
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		last = res
+	}
+}
+
+func (*server) DecimalAdd(ctx context.Context, req *pb.DecimalRequest) (*pb.DecimalResponse, error) {
+	fmt.Println("invoked DecimalAdd function")
+	return applyDecimal(req, addDecimals)
+}
+
+func (*server) DecimalSubtract(ctx context.Context, req *pb.DecimalRequest) (*pb.DecimalResponse, error) {
+	fmt.Println("invoked DecimalSubtract function")
+	return applyDecimal(req, subtractDecimals)
+}
+
+func (*server) DecimalMultiply(ctx context.Context, req *pb.DecimalRequest) (*pb.DecimalResponse, error) {
+	fmt.Println("invoked DecimalMultiply function")
+	return applyDecimal(req, multiplyDecimals)
+}
+
+func (*server) DecimalDivide(ctx context.Context, req *pb.DecimalRequest) (*pb.DecimalResponse, error) {
+	fmt.Println("invoked DecimalDivide function")
+	return applyDecimal(req, divideDecimals)
+}
+
+func (*server) DecimalSquareRoot(ctx context.Context, req *pb.DecimalSquareRootRequest) (*pb.DecimalResponse, error) {
+	fmt.Println("invoked DecimalSquareRoot function")
+	precision, err := decimalPrecision(req.GetContext(), "context.precision")
+	if err != nil {
+		return nil, err
+	}
+	number, err := parseDecimal(req.GetNumber())
+	if err != nil {
+		return nil, badDecimal("number", err)
+	}
+	if number.unscaled.Sign() < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative number: %v", number),
+		)
+	}
+
+	return &pb.DecimalResponse{
+		Result: round(sqrtDecimal(number, precision), precision, req.GetContext().GetRoundingMode()).String(),
+	}, nil
+}
+
 func main() {
 	lis, err := net.Listen("tcp", "0.0.0.0:50051")
 	if err != nil {
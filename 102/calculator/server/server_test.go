@@ -0,0 +1,119 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"math"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves the calculator over an in-memory connection
+func newTestClient(t *testing.T) pb.CalculatorServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	pb.RegisterCalculatorServiceServer(s, &server{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cc.Close() })
+	return pb.NewCalculatorServiceClient(cc)
+}
+
+func integer(v int64) *pb.Number {
+	return &pb.Number{Value: &pb.Number_IntValue{IntValue: v}}
+}
+
+func double(v float64) *pb.Number {
+	return &pb.Number{Value: &pb.Number_DoubleValue{DoubleValue: v}}
+}
+
+func TestSum(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Sum(context.Background(), &pb.SumRequest{FirstNumber: 5, SecondNumber: 3})
+	if err != nil || res.SumResult != 8 {
+		t.Fatalf("expected 8, got %v %v", res, err)
+	}
+	_, err = c.Sum(context.Background(), &pb.SumRequest{FirstNumber: math.MaxInt32, SecondNumber: 1})
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("expected OutOfRange, got %v", err)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	c := newTestClient(t)
+	type rpc func(context.Context, *pb.ArithmeticRequest, ...grpc.CallOption) (*pb.ArithmeticResponse, error)
+	tests := []struct {
+		name string
+		call rpc
+		a, b *pb.Number
+		want *pb.Number
+		code codes.Code
+	}{
+		{"add", c.Add, integer(2), integer(3), integer(5), codes.OK},
+		{"add doubles", c.Add, integer(2), double(0.5), double(2.5), codes.OK},
+		{"add overflow", c.Add, integer(math.MaxInt64), integer(1), nil, codes.OutOfRange},
+		{"add underflow", c.Add, integer(math.MinInt64), integer(-1), nil, codes.OutOfRange},
+		{"add double overflow", c.Add, double(math.MaxFloat64), double(math.MaxFloat64), nil, codes.OutOfRange},
+		{"subtract", c.Subtract, integer(2), integer(5), integer(-3), codes.OK},
+		{"subtract overflow", c.Subtract, integer(math.MinInt64), integer(1), nil, codes.OutOfRange},
+		{"multiply", c.Multiply, integer(-4), integer(6), integer(-24), codes.OK},
+		{"multiply overflow", c.Multiply, integer(math.MaxInt64/2 + 1), integer(2), nil, codes.OutOfRange},
+		{"multiply min by -1", c.Multiply, integer(math.MinInt64), integer(-1), nil, codes.OutOfRange},
+		{"divide", c.Divide, integer(7), integer(2), integer(3), codes.OK},
+		{"divide doubles", c.Divide, double(7), integer(2), double(3.5), codes.OK},
+		{"divide by zero", c.Divide, integer(7), integer(0), nil, codes.InvalidArgument},
+		{"divide double by zero", c.Divide, double(7), double(0), nil, codes.InvalidArgument},
+		{"divide overflow", c.Divide, integer(math.MinInt64), integer(-1), nil, codes.OutOfRange},
+		{"modulo", c.Modulo, integer(-7), integer(3), integer(-1), codes.OK},
+		{"modulo doubles", c.Modulo, double(7.5), integer(2), double(1.5), codes.OK},
+		{"modulo by zero", c.Modulo, integer(7), integer(0), nil, codes.InvalidArgument},
+		{"power", c.Power, integer(-2), integer(63), integer(math.MinInt64), codes.OK},
+		{"power of zero", c.Power, integer(5), integer(0), integer(1), codes.OK},
+		{"power negative exponent", c.Power, integer(2), integer(-2), double(0.25), codes.OK},
+		{"power overflow", c.Power, integer(2), integer(63), nil, codes.OutOfRange},
+		{"power double overflow", c.Power, double(10), integer(400), nil, codes.OutOfRange},
+		{"power zero to negative", c.Power, integer(0), integer(-1), nil, codes.InvalidArgument},
+		{"power undefined", c.Power, double(-8), double(1.0 / 3), nil, codes.InvalidArgument},
+		{"not finite", c.Add, double(math.Inf(1)), integer(1), nil, codes.InvalidArgument},
+		{"missing number", c.Add, integer(1), nil, nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.call(context.Background(), &pb.ArithmeticRequest{FirstNumber: tt.a, SecondNumber: tt.b})
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+			if tt.want == nil {
+				return
+			}
+			switch want := tt.want.GetValue().(type) {
+			case *pb.Number_IntValue:
+				got, ok := res.GetResult().GetValue().(*pb.Number_IntValue)
+				if !ok || got.IntValue != want.IntValue {
+					t.Errorf("expected %d, got %v", want.IntValue, res.GetResult())
+				}
+			case *pb.Number_DoubleValue:
+				got, ok := res.GetResult().GetValue().(*pb.Number_DoubleValue)
+				if !ok || got.DoubleValue != want.DoubleValue {
+					t.Errorf("expected %v, got %v", want.DoubleValue, res.GetResult())
+				}
+			}
+		})
+	}
+}
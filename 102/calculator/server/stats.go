@@ -0,0 +1,129 @@
+package main
+
+import (
+	"calculator/pb"
+	"math"
+	"sort"
+)
+
+// sketchSize is the number of centroids a sketch keeps, its percentiles
+// are exact until it has seen more numbers than that
+const sketchSize = 256
+
+// percentileRanks are the percentiles reported by ComputeStats
+var percentileRanks = []float64{1, 5, 10, 25, 50, 75, 90, 95, 99}
+
+// runningStats computes statistics of a stream of numbers in constant memory
+type runningStats struct {
+	count    int64
+	sum      float64
+	mean     float64
+	m2       float64 // sum of squared differences from the mean, as in Welford's algorithm
+	min, max float64
+	sketch   sketch
+}
+
+func (s *runningStats) add(x float64) {
+	if s.count == 0 || x < s.min {
+		s.min = x
+	}
+	if s.count == 0 || x > s.max {
+		s.max = x
+	}
+	s.count++
+	s.sum += x
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+	s.sketch.add(x)
+}
+
+func (s *runningStats) response() *pb.ComputeStatsResponse {
+	variance := s.m2 / float64(s.count)
+	res := &pb.ComputeStatsResponse{
+		Count:             s.count,
+		Sum:               s.sum,
+		Mean:              s.mean,
+		Min:               s.min,
+		Max:               s.max,
+		Variance:          variance,
+		StandardDeviation: math.Sqrt(variance),
+		Median:            s.sketch.quantile(0.5, s.min, s.max),
+	}
+	for _, rank := range percentileRanks {
+		res.Percentiles = append(res.Percentiles, &pb.Percentile{
+			Rank:  rank,
+			Value: s.sketch.quantile(rank/100, s.min, s.max),
+		})
+	}
+	return res
+}
+
+// centroid stands for count numbers around value
+type centroid struct {
+	value float64
+	count int64
+}
+
+// sketch estimates the quantiles of a stream with at most sketchSize
+// centroids: once full, every new number makes the two closest centroids
+// merge into their weighted mean (Ben-Haim and Tom-Tov's streaming histogram)
+type sketch struct {
+	centroids []centroid
+	count     int64
+}
+
+func (s *sketch) add(x float64) {
+	s.count++
+	i := sort.Search(len(s.centroids), func(i int) bool { return s.centroids[i].value >= x })
+	s.centroids = append(s.centroids, centroid{})
+	copy(s.centroids[i+1:], s.centroids[i:])
+	s.centroids[i] = centroid{value: x, count: 1}
+	if len(s.centroids) <= sketchSize {
+		return
+	}
+
+	closest := 0
+	for i := 1; i < len(s.centroids)-1; i++ {
+		if s.centroids[i+1].value-s.centroids[i].value < s.centroids[closest+1].value-s.centroids[closest].value {
+			closest = i
+		}
+	}
+	a, b := s.centroids[closest], s.centroids[closest+1]
+	count := a.count + b.count
+	s.centroids[closest] = centroid{
+		value: a.value + (b.value-a.value)*float64(b.count)/float64(count),
+		count: count,
+	}
+	s.centroids = append(s.centroids[:closest+1], s.centroids[closest+2:]...)
+}
+
+// quantile estimates the q quantile, 0 <= q <= 1, of the numbers added to
+// the sketch, min and max being the exact extremes of those numbers. The
+// numbers of a centroid are taken as spread evenly around its value, the
+// ones between two centroids as growing linearly from one to the other.
+func (s *sketch) quantile(q, min, max float64) float64 {
+	if len(s.centroids) == 0 {
+		return 0
+	}
+	rank := q * float64(s.count)
+	first, last := s.centroids[0], s.centroids[len(s.centroids)-1]
+	if half := float64(first.count) / 2; rank <= half {
+		return min + (first.value-min)*rank/half
+	}
+	if half := float64(last.count) / 2; rank >= float64(s.count)-half {
+		return last.value + (max-last.value)*(rank-float64(s.count)+half)/half
+	}
+
+	// center is the rank of the middle of centroid i
+	center := float64(first.count) / 2
+	for i := 0; i < len(s.centroids)-1; i++ {
+		a, b := s.centroids[i], s.centroids[i+1]
+		next := center + float64(a.count+b.count)/2
+		if rank <= next {
+			return a.value + (b.value-a.value)*(rank-center)/(next-center)
+		}
+		center = next
+	}
+	return last.value
+}
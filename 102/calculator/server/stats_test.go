@@ -0,0 +1,94 @@
+package main
+
+import (
+	"calculator/pb"
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunningStats(t *testing.T) {
+	stats := &runningStats{}
+	for _, x := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		stats.add(x)
+	}
+	res := stats.response()
+	if res.Count != 8 || res.Sum != 40 || res.Mean != 5 || res.Min != 2 || res.Max != 9 {
+		t.Errorf("unexpected stats %v", res)
+	}
+	if res.Variance != 4 || res.StandardDeviation != 2 || res.Median != 4.5 {
+		t.Errorf("unexpected spread %v", res)
+	}
+	if p := res.Percentiles[0]; p.Rank != 1 || p.Value < 2 || p.Value > 4 {
+		t.Errorf("unexpected 1st percentile %v", p)
+	}
+}
+
+func TestSketchBounded(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	stats := &runningStats{}
+	values := make([]float64, 200000)
+	for i := range values {
+		// a skewed distribution, most values are small
+		values[i] = rnd.ExpFloat64() * 100
+		stats.add(values[i])
+	}
+	if len(stats.sketch.centroids) > sketchSize {
+		t.Fatalf("the sketch grew to %d centroids", len(stats.sketch.centroids))
+	}
+
+	sort.Float64s(values)
+	res := stats.response()
+	for _, p := range res.Percentiles {
+		// compare ranks rather than values, the error the sketch makes
+		want := p.Rank / 100
+		got := float64(sort.SearchFloat64s(values, p.Value)) / float64(len(values))
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("percentile %v is %v, the actual rank of which is %.4f", p.Rank, p.Value, got)
+		}
+	}
+	if math.Abs(res.Mean-100) > 1 || math.Abs(res.StandardDeviation-100) > 1 {
+		t.Errorf("unexpected mean %v and standard deviation %v", res.Mean, res.StandardDeviation)
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	c := newTestClient(t)
+	stream, err := c.ComputeStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 100; i++ {
+		if err := stream.Send(&pb.ComputeStatsRequest{Number: float64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Count != 100 || res.Sum != 5050 || res.Mean != 50.5 || res.Median != 50.5 || len(res.Percentiles) != len(percentileRanks) {
+		t.Errorf("unexpected stats %v", res)
+	}
+
+	for name, numbers := range map[string][]float64{
+		"empty":      nil,
+		"not finite": {1, math.NaN()},
+	} {
+		stream, err := c.ComputeStats(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, x := range numbers {
+			stream.Send(&pb.ComputeStatsRequest{Number: x})
+		}
+		if _, err := stream.CloseAndRecv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}